@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/tfbrew/terraform-provider-awx/internal/provider"
+)
+
+// This program writes the objects of one organization as Terraform configuration, plus the import blocks that
+// bring them under management. It connects the same way the provider does without a provider block, from the
+// TOWER_* or CONTROLLER_* environment variables or the config file.
+func main() {
+	var options provider.ExportOptions
+
+	flag.StringVar(&options.Organization, "organization", "", "name of the organization to export")
+	flag.StringVar(&options.Directory, "out", "export", "directory to write the .tf files to")
+	flag.Parse()
+
+	if options.Organization == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	addresses, err := provider.Export(context.Background(), "export", options)
+
+	// undo the login, if one was made
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), provider.ShutdownTimeout)
+	provider.Shutdown(shutdownCtx)
+	cancel()
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	for _, address := range addresses {
+		fmt.Println(address)
+	}
+	fmt.Printf("Exported %d resources to %s.\n", len(addresses), options.Directory)
+	fmt.Printf("Not exported, as the exporter doesn't support them yet: %s.\n", strings.Join(provider.ExportUnsupportedTypes(), ", "))
+}
@@ -0,0 +1,17 @@
+action "awx_inventory_source_update" "cloud" {
+  config {
+    inventory_source = awx_inventory_source.cloud.id
+  }
+}
+
+# refresh the hosts once the source is created or changed
+resource "terraform_data" "cloud_source" {
+  input = awx_inventory_source.cloud.source_vars
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.awx_inventory_source_update.cloud]
+    }
+  }
+}
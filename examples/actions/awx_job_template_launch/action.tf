@@ -0,0 +1,26 @@
+action "awx_job_template_launch" "deploy" {
+  config {
+    job_template = awx_job_template.deploy.id
+    limit        = "web*"
+    extra_vars = jsonencode({
+      release = var.release
+    })
+
+    timeouts {
+      invoke = "30m"
+    }
+  }
+}
+
+# deploy every new release, or on demand with
+# terraform apply -invoke=action.awx_job_template_launch.deploy
+resource "terraform_data" "release" {
+  input = var.release
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.awx_job_template_launch.deploy]
+    }
+  }
+}
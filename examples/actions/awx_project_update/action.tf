@@ -0,0 +1,22 @@
+action "awx_project_update" "sync" {
+  config {
+    project = awx_project.example.id
+
+    timeouts {
+      invoke = "10m"
+    }
+  }
+}
+
+# sync the project whenever the release changes, or on demand with
+# terraform apply -invoke=action.awx_project_update.sync
+resource "terraform_data" "release" {
+  input = var.release
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.awx_project_update.sync]
+    }
+  }
+}
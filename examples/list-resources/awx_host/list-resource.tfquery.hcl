@@ -0,0 +1,8 @@
+list "awx_host" "example" {
+  provider         = awx
+  include_resource = true
+
+  config {
+    inventory = 1
+  }
+}
@@ -0,0 +1,8 @@
+list "awx_job_template" "example" {
+  provider         = awx
+  include_resource = true
+
+  config {
+    organization = 1
+  }
+}
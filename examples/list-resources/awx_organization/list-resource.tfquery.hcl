@@ -0,0 +1,8 @@
+list "awx_organization" "example" {
+  provider         = awx
+  include_resource = true
+
+  config {
+    search = "Default"
+  }
+}
@@ -0,0 +1,8 @@
+list "awx_project" "example" {
+  provider         = awx
+  include_resource = true
+
+  config {
+    organization = 1
+  }
+}
@@ -0,0 +1,8 @@
+list "awx_schedule" "example" {
+  provider         = awx
+  include_resource = true
+
+  config {
+    enabled = true
+  }
+}
@@ -21,7 +21,61 @@ provider "awx" {
   endpoint = "http://aap.example.com"
   token    = "mysecrettoken"
   api_retry = {
-    api_retry_count         = 1
-    api_retry_delay_seconds = 2
+    api_retry_count               = 3
+    api_retry_delay_seconds       = 2
+    api_retry_exponential_backoff = true
+    api_retry_jitter              = true
+    api_retry_max_delay_seconds   = 30
+    api_retry_mutating_requests   = true
   }
-}
\ No newline at end of file
+}
+
+provider "awx" {
+  endpoint             = "https://aap.internal.example.com"
+  token                = "mysecrettoken"
+  ca_cert_file         = "/etc/pki/tls/certs/internal-ca.pem"
+  client_cert_file     = "/etc/pki/tls/certs/terraform.pem"
+  client_key_file      = "/etc/pki/tls/private/terraform.key"
+  insecure_skip_verify = false
+}
+
+provider "awx" {
+  endpoint                = "https://aap.example.com"
+  token                   = "mysecrettoken"
+  request_timeout_seconds = 120
+  proxy_url               = "http://proxy.example.com:3128"
+  request_headers = {
+    "X-Tenant-Id"      = "platform-team"
+    "X-Correlation-Id" = "terraform-nightly"
+  }
+}
+
+provider "awx" {
+  endpoint                = "https://aap.example.com"
+  token                   = "mysecrettoken"
+  max_concurrent_requests = 8
+  requests_per_second     = 20
+}
+
+provider "awx" {
+  endpoint = "https://aap.example.com"
+  token    = "mysecrettoken"
+  platform = "aap"
+}
+
+# Read host and credentials from the [staging] section of a tower-cli style config file.
+# Each setting is taken from the first of: this block, TOWER_* environment variables,
+# CONTROLLER_* environment variables, then the config file profile.
+provider "awx" {
+  config_file = "~/.tower_cli.cfg"
+  profile     = "staging"
+}
+
+# Log in once with the platform gateway's session login instead of sending
+# HTTP Basic credentials with every request.
+provider "awx" {
+  endpoint    = "https://aap.example.com"
+  username    = "admin"
+  password    = "password"
+  auth_method = "session"
+}
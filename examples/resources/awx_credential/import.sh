@@ -1,9 +1,12 @@
 # The example below is for a simple import that can be used when you do not have an inputs attribute block defined with secrets.
 terraform import awx_credential.example 5
 
+# Or by organization name, credential type name and credential name
+terraform import awx_credential.example "Default/Machine/root"
+
 # If you have an inputs attribute object block defined with secrets, you need to specify them in the import command ID.
 # The example below shows the pattern for the import command when you have an inputs attribute block defined with secrets in your .tf file.
-# The ID field for the import command is the resources's ID followed by a comma-separated list of key/value pairs.
+# The ID field for the import command is the resources's ID (numeric, or organization/credential-type/name) followed by a comma-separated list of key/value pairs.
 # Non-secret inputs do not need to be included in the import command
 # The string at the end of this example command below would correlate to the following resource definition:
 #   resource "awx_credential" "example_with_input" {
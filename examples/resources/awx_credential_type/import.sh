@@ -1 +1,4 @@
 terraform import awx_credential_type.example 5
+
+# Or by credential type name
+terraform import awx_credential_type.example "Machine"
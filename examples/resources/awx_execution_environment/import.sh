@@ -1 +1,4 @@
 terraform import awx_execution_environment.example 5
+
+# Or by execution environment name
+terraform import awx_execution_environment.example "Default execution environment"
@@ -1 +1,4 @@
 terraform import awx_group.example 1
+
+# Or by inventory name and group name
+terraform import awx_group.example "Servers/webservers"
@@ -0,0 +1,2 @@
+# Import a host's membership of a group via the group's ID and the host's ID
+terraform import awx_group_host.example 10/25
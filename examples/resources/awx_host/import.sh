@@ -1 +1,4 @@
 terraform import awx_host.example 1
+
+# Or by inventory name and host name
+terraform import awx_host.example "Servers/web01"
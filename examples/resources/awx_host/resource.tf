@@ -19,3 +19,13 @@ resource "awx_host" "example-variables" {
     }
   )
 }
+
+resource "awx_host" "example-variables-map" {
+  name        = "web1"
+  description = "Example with variables given as an object"
+  inventory   = awx_inventory.example.id
+  variables_map = {
+    ansible_host = "10.0.0.1"
+    ansible_port = 22
+  }
+}
@@ -1 +1,4 @@
 terraform import awx_instance_group.example 1
+
+# Or by instance group name
+terraform import awx_instance_group.example "default"
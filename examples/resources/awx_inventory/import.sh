@@ -1 +1,4 @@
 terraform import awx_inventory.example 1
+
+# Or by organization name and inventory name
+terraform import awx_inventory.example "Default/Servers"
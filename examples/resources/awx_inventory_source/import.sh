@@ -1 +1,4 @@
 terraform import awx_inventory_source.example 1
+
+# Or by inventory name and inventory source name
+terraform import awx_inventory_source.example "Servers/AWS"
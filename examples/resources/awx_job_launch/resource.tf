@@ -0,0 +1,20 @@
+resource "awx_job_launch" "bootstrap" {
+  job_template = awx_job_template.bootstrap.id
+  limit        = "web*"
+  extra_vars = jsonencode({
+    release = "1.2.3"
+  })
+
+  # launch again whenever the project switches branches
+  triggers = {
+    scm_branch = awx_project.example.scm_branch
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+output "bootstrap_artifacts" {
+  value = awx_job_launch.bootstrap.artifacts
+}
@@ -1 +1,4 @@
 terraform import awx_job_template.example 100
+
+# Or by organization name and job template name
+terraform import awx_job_template.example "Default/Deploy Web"
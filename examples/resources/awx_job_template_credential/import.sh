@@ -1,2 +1,5 @@
 # Import credentials associated a specific job template via the job template's ID
-terraform import awx_job_template_credential.example 100
\ No newline at end of file
+terraform import awx_job_template_credential.example 100
+
+# Or by job template's organization name and name
+terraform import awx_job_template_credential.example "Default/Deploy Web"
@@ -1 +1,4 @@
-terraform import awx_job_template_label.example 100
\ No newline at end of file
+terraform import awx_job_template_label.example 100
+
+# Or by job template's organization name and name
+terraform import awx_job_template_label.example "Default/Deploy Web"
@@ -1 +1,4 @@
 terraform import awx_job_template_notification_template_success.example 100
+
+# Or by job template's organization name and name
+terraform import awx_job_template_notification_template_success.example "Default/Deploy Web"
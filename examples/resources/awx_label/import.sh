@@ -1 +1,4 @@
-terraform import awx_label.example 1
\ No newline at end of file
+terraform import awx_label.example 1
+
+# Or by organization name and label name
+terraform import awx_label.example "Default/production"
@@ -1 +1,4 @@
-terraform import awx_notification_template.example 100
\ No newline at end of file
+terraform import awx_notification_template.example 100
+
+# Or by organization name and notification template name
+terraform import awx_notification_template.example "Default/Ops Slack"
@@ -1 +1,4 @@
 terraform import awx_organization.example 1
+
+# Or by organization name
+terraform import awx_organization.example "Default"
@@ -1 +1,4 @@
 terraform import awx_project.example 1
+
+# Or by organization name and project name
+terraform import awx_project.example "Default/Playbooks"
@@ -8,6 +8,13 @@ resource "awx_project" "example-git" {
   organization = awx_organization.example.id
   scm_type     = "git"
   scm_url      = "git@github.com:user/repo.git"
+
+  # job templates using the project need its playbooks to have been synced
+  wait_for_sync = true
+
+  timeouts {
+    create = "30m"
+  }
 }
 
 resource "awx_project" "example-svn" {
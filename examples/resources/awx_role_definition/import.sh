@@ -1 +1,4 @@
 terraform import awx_role_definition.example 1
+
+# Or by role definition name
+terraform import awx_role_definition.example "Organization Admin"
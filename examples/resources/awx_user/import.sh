@@ -2,3 +2,6 @@
 # The first plan/apply after import will result in a modification to the password so that the state can be updated.
 
 terraform import awx_user.example 1
+
+# Or by username
+terraform import awx_user.example "admin"
@@ -0,0 +1,23 @@
+resource "awx_workflow_job_launch" "rollout" {
+  workflow_job_template = awx_workflow_job_template.rollout.id
+  extra_vars = jsonencode({
+    release = var.release
+  })
+
+  # approve the workflow's approval nodes rather than failing on them
+  pending_approvals = "approve"
+
+  triggers = {
+    release = var.release
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
+
+output "rollout_nodes" {
+  value = {
+    for node in awx_workflow_job_launch.rollout.nodes : node.name => node.status
+  }
+}
@@ -1 +1,4 @@
-terraform import awx_workflow_job_template.example 100
\ No newline at end of file
+terraform import awx_workflow_job_template.example 100
+
+# Or by organization name and workflow job template name
+terraform import awx_workflow_job_template.example "Default/Release"
@@ -1 +1,4 @@
 terraform import awx_workflow_job_template_notification_template_approvals.example 100
+
+# Or by workflow job template's organization name and name
+terraform import awx_workflow_job_template_notification_template_approvals.example "Default/Release"
@@ -1 +1,4 @@
-terraform import awx_workflow_job_template_survey_spec.example 100
\ No newline at end of file
+terraform import awx_workflow_job_template_survey_spec.example 100
+
+# Or by workflow job template's organization name and name
+terraform import awx_workflow_job_template_survey_spec.example "Default/Release"
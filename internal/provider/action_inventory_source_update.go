@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+var _ action.Action = &InventorySourceUpdateAction{}
+var _ action.ActionWithConfigure = &InventorySourceUpdateAction{}
+
+func NewInventorySourceUpdateAction() action.Action {
+	return &InventorySourceUpdateAction{}
+}
+
+type InventorySourceUpdateAction struct {
+	client *providerClient
+}
+
+func (a *InventorySourceUpdateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_source_update"
+}
+
+func (a *InventorySourceUpdateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Syncs an inventory source and waits for the inventory update to finish, reporting its output as it runs. Fails when the update does not succeed.",
+		Attributes: map[string]schema.Attribute{
+			"inventory_source": schema.Int32Attribute{
+				Description: "ID of the inventory source to update.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockWithOpts(ctx, timeouts.Opts{
+				InvokeDescription: "How long to wait for the inventory update to finish, as a duration such as `\"10m\"`. Defaults to `\"60m\"`.",
+			}),
+		},
+	}
+}
+
+func (a *InventorySourceUpdateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureActionClient(req, &resp.Diagnostics)
+}
+
+func (a *InventorySourceUpdateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InventorySourceUpdateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Invoke(ctx, defaultActionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.client.runUnifiedJobAction(ctx, unifiedJobLaunch{
+		url:          fmt.Sprintf("inventory_sources/%d/update/", data.InventorySource.ValueInt32()),
+		successCodes: []int{202},
+		jobType:      "inventory_update",
+	}, timeout, resp)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &JobTemplateLaunchAction{}
+var _ action.ActionWithConfigure = &JobTemplateLaunchAction{}
+
+func NewJobTemplateLaunchAction() action.Action {
+	return &JobTemplateLaunchAction{}
+}
+
+type JobTemplateLaunchAction struct {
+	client *providerClient
+}
+
+func (a *JobTemplateLaunchAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_template_launch"
+}
+
+func (a *JobTemplateLaunchAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Launches a job template and waits for the job to finish, reporting its output as it runs. Fails when the job does not succeed. Unlike the `job_launch` resource, nothing is kept in state.",
+		Attributes: map[string]schema.Attribute{
+			"job_template": schema.Int32Attribute{
+				Description: "ID of the job template to launch.",
+				Required:    true,
+			},
+			"extra_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
+				Description: "Extra variables for the job, in JSON or YAML format. The job template must prompt for variables on launch, or enable its survey.",
+				Optional:    true,
+			},
+			"limit": schema.StringAttribute{
+				Description: "Host pattern limiting the hosts the job runs against. The job template must prompt for the limit on launch.",
+				Optional:    true,
+			},
+			"inventory": schema.Int32Attribute{
+				Description: "ID of the inventory to run the job against. The job template must prompt for the inventory on launch.",
+				Optional:    true,
+			},
+			"credentials": schema.SetAttribute{
+				ElementType: types.Int32Type,
+				Description: "IDs of the credentials to run the job with, replacing the job template's. The job template must prompt for credentials on launch.",
+				Optional:    true,
+			},
+			"job_tags": schema.StringAttribute{
+				Description: "Comma separated tags to run. The job template must prompt for tags on launch.",
+				Optional:    true,
+			},
+			"skip_tags": schema.StringAttribute{
+				Description: "Comma separated tags to skip. The job template must prompt for skip tags on launch.",
+				Optional:    true,
+			},
+			"job_type": schema.StringAttribute{
+				Description: "Either `run` or `check`. The job template must prompt for the job type on launch.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"run", "check"}...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockWithOpts(ctx, timeouts.Opts{
+				InvokeDescription: "How long to wait for the job to finish, as a duration such as `\"30m\"` or `\"2h\"`. Defaults to `\"60m\"`.",
+			}),
+		},
+	}
+}
+
+func (a *JobTemplateLaunchAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureActionClient(req, &resp.Diagnostics)
+}
+
+func (a *JobTemplateLaunchAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data JobTemplateLaunchActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Invoke(ctx, defaultActionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var bodyData JobLaunchAPIModel
+	bodyData.ExtraVars = data.ExtraVars.ValueString()
+	bodyData.Limit = data.Limit.ValueString()
+	bodyData.Inventory = int(data.Inventory.ValueInt32())
+	bodyData.JobTags = data.JobTags.ValueString()
+	bodyData.SkipTags = data.SkipTags.ValueString()
+	bodyData.JobType = data.JobType.ValueString()
+
+	if !data.Credentials.IsNull() {
+		resp.Diagnostics.Append(data.Credentials.ElementsAs(ctx, &bodyData.Credentials, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	a.client.runUnifiedJobAction(ctx, unifiedJobLaunch{
+		url:          fmt.Sprintf("job_templates/%d/launch/", data.JobTemplate.ValueInt32()),
+		body:         bodyData,
+		successCodes: []int{201},
+		jobType:      "job",
+	}, timeout, resp)
+}
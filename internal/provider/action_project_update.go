@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+var _ action.Action = &ProjectUpdateAction{}
+var _ action.ActionWithConfigure = &ProjectUpdateAction{}
+
+func NewProjectUpdateAction() action.Action {
+	return &ProjectUpdateAction{}
+}
+
+type ProjectUpdateAction struct {
+	client *providerClient
+}
+
+func (a *ProjectUpdateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_update"
+}
+
+func (a *ProjectUpdateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Syncs a project with its source control repository and waits for the project update to finish, reporting its output as it runs. Fails when the update does not succeed.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.Int32Attribute{
+				Description: "ID of the project to update.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockWithOpts(ctx, timeouts.Opts{
+				InvokeDescription: "How long to wait for the project update to finish, as a duration such as `\"10m\"`. Defaults to `\"60m\"`.",
+			}),
+		},
+	}
+}
+
+func (a *ProjectUpdateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureActionClient(req, &resp.Diagnostics)
+}
+
+func (a *ProjectUpdateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ProjectUpdateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Invoke(ctx, defaultActionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.client.runUnifiedJobAction(ctx, unifiedJobLaunch{
+		url:          fmt.Sprintf("projects/%d/update/", data.Project.ValueInt32()),
+		successCodes: []int{202},
+		jobType:      "project_update",
+	}, timeout, resp)
+}
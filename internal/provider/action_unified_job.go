@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// How long an action waits for its job when the timeouts block doesn't say.
+const defaultActionTimeout = 60 * time.Minute
+
+// What an action starts: the endpoint that launches the job, and the type of the job it launches, i.e.
+// "project_update", which names both the field of the launch response holding the job's id and, pluralized, the
+// collection the job is read from.
+type unifiedJobLaunch struct {
+	url          string
+	body         any
+	successCodes []int
+	jobType      string
+}
+
+// Returns the provider's client to an action's Configure.
+func configureActionClient(req action.ConfigureRequest, diags *diag.Diagnostics) *providerClient {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+	if !ok {
+		diags.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+
+	return client
+}
+
+// Launches a job and waits up to timeout for it to finish, sending each line of its output to Terraform as a
+// progress event. A job that doesn't succeed fails the action.
+func (c *providerClient) runUnifiedJobAction(ctx context.Context, launch unifiedJobLaunch, timeout time.Duration, resp *action.InvokeResponse) {
+	returnedData, _, err := c.CreateUpdateAPIRequest(ctx, http.MethodPost, launch.url, launch.body, launch.successCodes, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	jobID, ok := returnedData[launch.jobType].(float64)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error retrieving computed values",
+			fmt.Sprintf("Could not retrieve %s.", launch.jobType))
+		return
+	}
+
+	warnIgnoredLaunchFields(&resp.Diagnostics, returnedData)
+
+	jobURL := fmt.Sprintf("%ss/%d/", launch.jobType, int(jobID))
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started %s %d.", launch.jobType, int(jobID))})
+
+	nextLine := 0
+	sendStdout := func(ctx context.Context) error {
+		lines, next, err := c.unifiedJobStdout(ctx, jobURL, nextLine)
+		for _, line := range lines {
+			resp.SendProgress(action.InvokeProgressEvent{Message: line})
+		}
+		nextLine = next
+		return err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// output is best effort while the job runs; only a failure to read the rest of it is reported
+	job, err := c.waitForUnifiedJob(waitCtx, jobURL, func(unifiedJobAPIModel) error {
+		_ = sendStdout(waitCtx)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for job",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	// the output written between the last poll and the job finishing
+	if err := sendStdout(ctx); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read job output",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+	}
+
+	if job.Status != "successful" {
+		resp.Diagnostics.AddError(
+			"Job did not succeed",
+			job.failureDescription())
+	}
+}
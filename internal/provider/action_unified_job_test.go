@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func actionTimeouts(invoke string) timeouts.Value {
+	attributeTypes := map[string]attr.Type{"invoke": types.StringType}
+	if invoke == "" {
+		return timeouts.Value{Object: types.ObjectNull(attributeTypes)}
+	}
+
+	return timeouts.Value{Object: types.ObjectValueMust(attributeTypes, map[string]attr.Value{"invoke": types.StringValue(invoke)})}
+}
+
+// Configures and invokes an action the way the framework would during an apply, with config as its tfsdk model.
+// Returns the messages of the progress events it sent, and its diagnostics.
+func invokeAction[T any](t *testing.T, newAction func() action.Action, client *providerClient, config T) ([]string, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	a := newAction()
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+
+	configureResp := &action.ConfigureResponse{}
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: client}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configure: %v", configureResp.Diagnostics)
+	}
+
+	// tfsdk.Config can't be set from a model, but a state of the same schema can
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) { progress = append(progress, event.Message) },
+	}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+
+	return progress, resp.Diagnostics
+}
+
+func TestProjectUpdateAction_offline(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	projectID := fake.seed("projects", map[string]any{"name": "playbooks"})
+	fake.onLaunch = func(jobCollection string, jobID int64) {
+		fake.objects[jobCollection][jobID]["stdout"] = "\x1b[0;32mok: [localhost]\x1b[0m\r\nPLAY RECAP\r\n"
+	}
+
+	progress, diags := invokeAction(t, NewProjectUpdateAction, fake.client(), ProjectUpdateActionModel{
+		Project:  types.Int32Value(int32(projectID)),
+		Timeouts: actionTimeouts(""),
+	})
+	if diags.HasError() {
+		t.Fatalf("invoke: %v", diags)
+	}
+
+	updates := fake.allObjects("project_updates")
+	if len(updates) != 1 || updates[0]["project"] != projectID {
+		t.Fatalf("expected one update of the project, got %v", updates)
+	}
+
+	// each line of output is sent once, without color, whether it was read while polling or after
+	if !slices.Equal(progress[1:], []string{"ok: [localhost]", "PLAY RECAP"}) {
+		t.Errorf("expected the update's output as progress, got %q", progress)
+	}
+}
+
+func TestInventorySourceUpdateAction_failedUpdate(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	inventorySourceID := fake.seed("inventory_sources", map[string]any{"name": "cloud"})
+	fake.setJobOutcome(map[string]any{"status": "failed", "failed": true, "job_explanation": "Source credentials were rejected."})
+
+	_, diags := invokeAction(t, NewInventorySourceUpdateAction, fake.client(), InventorySourceUpdateActionModel{
+		InventorySource: types.Int32Value(int32(inventorySourceID)),
+		Timeouts:        actionTimeouts(""),
+	})
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "Source credentials were rejected.") {
+		t.Errorf("expected the failed update to fail the action, got %v", diags)
+	}
+	if updates := fake.allObjects("inventory_updates"); len(updates) != 1 || updates[0]["inventory_source"] != inventorySourceID {
+		t.Errorf("expected one update of the inventory source, got %v", updates)
+	}
+}
+
+func TestJobTemplateLaunchAction_timeout(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy"})
+	fake.setJobOutcome(map[string]any{"status": "running"})
+
+	_, diags := invokeAction(t, NewJobTemplateLaunchAction, fake.client(), JobTemplateLaunchActionModel{
+		JobTemplate: types.Int32Value(int32(jobTemplateID)),
+		ExtraVars:   NewVariablesStringValue("release: 1.2.3"),
+		Limit:       types.StringValue("web*"),
+		Inventory:   types.Int32Null(),
+		Credentials: types.SetNull(types.Int32Type),
+		JobTags:     types.StringNull(),
+		SkipTags:    types.StringNull(),
+		JobType:     types.StringNull(),
+		Timeouts:    actionTimeouts("50ms"),
+	})
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "gave up waiting for job") {
+		t.Errorf("expected the action to give up on the running job, got %v", diags)
+	}
+
+	jobs := fake.allObjects("jobs")
+	if len(jobs) != 1 || jobs[0]["limit"] != "web*" || jobs[0]["extra_vars"] != "release: 1.2.3" {
+		t.Errorf("expected the job to be launched with the prompted fields, got %v", jobs)
+	}
+}
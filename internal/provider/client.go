@@ -1,25 +1,48 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	urlParser "net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
-	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type providerClient struct {
-	client               *http.Client
-	endpoint             string
-	auth                 string
-	urlPrefix            string
-	apiRetryCount        int32
-	apiRetryDelaySeconds int32
+	client                     *http.Client
+	endpoint                   string
+	auth                       string
+	urlPrefix                  string
+	platform                   string
+	headers                    map[string]string
+	apiRetryCount              int32
+	apiRetryDelaySeconds       int32
+	apiRetryExponentialBackoff bool
+	apiRetryJitter             bool
+	apiRetryMaxDelaySeconds    int32
+	apiRetryMutatingRequests   bool
+	limiter                    *requestLimiter
+	controller                 controllerInfo
+
+	// login is nil when every request carries static credentials in auth. Otherwise auth holds the session or
+	// token from the last login; authMu guards it, and loginMu serializes logging in.
+	login          *loginConfig
+	authMu         sync.RWMutex
+	authGeneration int
+	loginMu        sync.Mutex
 }
 
 // A wrapper for http.NewRequestWithContext() that prepends tower endpoint to URL & sets authorization
@@ -28,177 +51,421 @@ func (c *providerClient) GenericAPIRequest(ctx context.Context, method, url stri
 
 	url = c.buildAPIUrl(url, aap25_api_endpoint_hint)
 
-	var body io.Reader
+	responseBody, statusCode, success, errorMessage := c.doAPIRequest(ctx, method, url, requestBody, successCodes)
+	if errorMessage != nil {
+		return
+	}
 
-	if requestBody != nil {
-		jsonData, err := json.Marshal(requestBody)
-		if err != nil {
-			errorMessage = fmt.Errorf("unable to marshal requestBody into json: %s", err.Error())
-			return
+	if !success {
+		errorMessage = newAPIError(statusCode, successCodes, responseBody)
+		return
+	}
+
+	return
+}
+
+func SleepWithContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	select {
+	case <-ctx.Done():
+		if !timer.Stop() {
+			<-timer.C
 		}
+	case <-timer.C:
+	}
+}
+
+func (c *providerClient) CreateUpdateAPIRequest(ctx context.Context, method, url string, requestBody any, successCodes []int, aap25_api_endpoint_hint string) (returnedData map[string]any, statusCode int, errorMessage error) {
 
-		body = strings.NewReader(string(jsonData))
+	url = c.buildAPIUrl(url, aap25_api_endpoint_hint)
+
+	httpRespBodyData, statusCode, success, errorMessage := c.doAPIRequest(ctx, method, url, requestBody, successCodes)
+	if errorMessage != nil {
+		return
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if !success {
+		errorMessage = newAPIError(statusCode, successCodes, httpRespBodyData)
+		return
+	}
+
+	err := json.Unmarshal(httpRespBodyData, &returnedData)
 	if err != nil {
-		errorMessage = fmt.Errorf("error generating http request: %v", err)
+		errorMessage = errors.New("unable to unmarshal http request response body to retrieve returnedData")
 		return
 	}
-	httpReq.Header.Add("Content-Type", "application/json")
-	httpReq.Header.Add("Authorization", c.auth)
+	return
+}
 
-	var success bool
-	var httpResp *http.Response
-	var maxAttempts int
+// Sends the request to an already built url, retrying according to the provider's api_retry settings.
+// The http request, and so its body reader, is rebuilt for every attempt. success reports whether the final
+// status code was one of successCodes; responseBody is the body of the final attempt.
+func (c *providerClient) doAPIRequest(ctx context.Context, method, url string, requestBody any, successCodes []int) (responseBody []byte, statusCode int, success bool, errorMessage error) {
 
-	if method == http.MethodGet {
-		maxAttempts = 1 + int(c.apiRetryCount)
-	} else {
-		maxAttempts = 1
+	var jsonData []byte
+
+	if requestBody != nil {
+		var err error
+		jsonData, err = json.Marshal(requestBody)
+		if err != nil {
+			errorMessage = fmt.Errorf("unable to marshal requestBody into json: %s", err.Error())
+			return
+		}
 	}
 
-	for i := 0; i < maxAttempts; i++ {
-		httpResp, err = c.client.Do(httpReq)
+	ctx = apiLogContext(ctx)
+
+	maxAttempts := 1 + int(c.apiRetryCount)
+	reauthenticated := false
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		var body io.Reader
+		if jsonData != nil {
+			body = bytes.NewReader(jsonData)
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
 		if err != nil {
-			errorMessage = fmt.Errorf("error doing http request: %v", err)
+			errorMessage = fmt.Errorf("error generating http request: %v", err)
 			return
 		}
+		for name, value := range c.headers {
+			httpReq.Header.Set(name, value)
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		auth, authGeneration := c.currentAuth()
+		if auth != "" {
+			httpReq.Header.Set("Authorization", auth)
+		}
+		c.setSessionHeaders(httpReq)
 
-		for _, successCode := range successCodes {
-			if httpResp.StatusCode == successCode {
-				success = true
-				break
+		lastAttempt := attempt == maxAttempts-1
+
+		logFields := map[string]any{
+			"method":  method,
+			"url":     url,
+			"attempt": attempt + 1,
+		}
+
+		waited, err := c.limiter.acquire(ctx)
+		if err != nil {
+			errorMessage = fmt.Errorf("error waiting for a free API request slot: %v", err)
+			return
+		}
+		if waited > 0 {
+			tflog.SubsystemDebug(ctx, apiLogSubsystem, "Waited for client-side API request limit", mergeFields(logFields, map[string]any{
+				"wait_ms": waited.Milliseconds(),
+			}))
+		}
+
+		tflog.SubsystemTrace(ctx, apiLogSubsystem, "Sending API request", mergeFields(logFields, map[string]any{
+			"request_headers": redactHeaders(httpReq.Header),
+			"request_body":    redactBody(jsonData),
+		}))
+
+		start := time.Now()
+
+		httpResp, err := c.client.Do(httpReq)
+		if err != nil {
+			c.limiter.release()
+
+			tflog.SubsystemDebug(ctx, apiLogSubsystem, "API request failed", mergeFields(logFields, map[string]any{
+				"latency_ms": time.Since(start).Milliseconds(),
+				"error":      err.Error(),
+			}))
+
+			if lastAttempt || !c.shouldRetryError(method, err) {
+				errorMessage = fmt.Errorf("error doing http request: %v", err)
+				return
 			}
+
+			SleepWithContext(ctx, c.retryDelay(attempt, nil))
+			continue
 		}
 
-		if success {
-			break
+		statusCode = httpResp.StatusCode
+		responseBody, err = io.ReadAll(httpResp.Body)
+		httpResp.Body.Close()
+		c.limiter.release()
+
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "API request completed", mergeFields(logFields, map[string]any{
+			"status_code": statusCode,
+			"latency_ms":  time.Since(start).Milliseconds(),
+		}))
+		tflog.SubsystemTrace(ctx, apiLogSubsystem, "API response body", mergeFields(logFields, map[string]any{
+			"status_code":   statusCode,
+			"response_body": redactBody(responseBody),
+		}))
+
+		if err != nil {
+			errorMessage = fmt.Errorf("unable to read the http response data body. body: %v", responseBody)
+			return
 		}
 
-		// if not success & have remaining attempts
-		if !success && ((maxAttempts - i) > 1) {
-			SleepWithContext(ctx, time.Duration(c.apiRetryDelaySeconds)*time.Second)
+		if slices.Contains(successCodes, statusCode) {
+			success = true
+			return
 		}
 
+		// an expired session or token is renewed once per request, without using up a retry attempt
+		if statusCode == http.StatusUnauthorized && c.login != nil && !reauthenticated {
+			reauthenticated = true
+
+			if err := c.reauthenticate(ctx, authGeneration); err != nil {
+				tflog.SubsystemDebug(ctx, apiLogSubsystem, "Unable to log in again", mergeFields(logFields, map[string]any{
+					"error": err.Error(),
+				}))
+				return
+			}
+
+			attempt--
+			continue
+		}
+
+		if lastAttempt || !c.shouldRetryStatus(method, statusCode) {
+			return
+		}
+
+		delay := c.retryDelay(attempt, httpResp)
+
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "Retrying API request", mergeFields(logFields, map[string]any{
+			"status_code": statusCode,
+			"delay_ms":    delay.Milliseconds(),
+		}))
+
+		SleepWithContext(ctx, delay)
 	}
 
-	responseBody, err = io.ReadAll(httpResp.Body)
-	statusCode = httpResp.StatusCode
+	return
+}
 
-	if err != nil {
-		errorMessage = fmt.Errorf("unable to read the http response data body. body: %v", responseBody)
-		return
+// GET requests are retried on any unexpected status code, as they always have been. Requests that change
+// data are only retried when the provider is configured to do so, and then only on codes that mean the
+// controller never processed the request.
+func (c *providerClient) shouldRetryStatus(method string, statusCode int) bool {
+	if method == http.MethodGet {
+		return true
 	}
-	defer httpResp.Body.Close()
 
-	if !success {
-		errorMessage = fmt.Errorf("expected %v http response code for API call, got %d with message %s", successCodes, statusCode, responseBody)
-		return
+	if !c.apiRetryMutatingRequests {
+		return false
 	}
 
-	return
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
 }
 
-func SleepWithContext(ctx context.Context, d time.Duration) {
-	timer := time.NewTimer(d)
-	select {
-	case <-ctx.Done():
-		if !timer.Stop() {
-			<-timer.C
-		}
-	case <-timer.C:
+// Only transport errors where the connection was dropped or refused are worth another attempt. Timeouts are
+// only retried for GET requests, as a request that changes data may have been processed before the timeout.
+// A cancelled or expired context is never retried.
+func (c *providerClient) shouldRetryError(method string, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if method != http.MethodGet && !c.apiRetryMutatingRequests {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
 	}
+
+	var netErr net.Error
+	if method == http.MethodGet && errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return false
 }
 
-func (c *providerClient) CreateUpdateAPIRequest(ctx context.Context, method, url string, requestBody any, successCodes []int, aap25_api_endpoint_hint string) (returnedData map[string]any, statusCode int, errorMessage error) {
+// How long to wait before the retry that follows the given (zero based) attempt. With exponential backoff the
+// configured delay doubles after each attempt, up to api_retry_max_delay_seconds. Jitter picks a random delay
+// between half and all of that value so that parallel requests don't retry in lockstep. A Retry-After header
+// on a 429 or 503 response takes precedence when it asks for a longer wait.
+func (c *providerClient) retryDelay(attempt int, httpResp *http.Response) time.Duration {
+	delay := time.Duration(c.apiRetryDelaySeconds) * time.Second
 
-	url = c.buildAPIUrl(url, aap25_api_endpoint_hint)
+	if c.apiRetryExponentialBackoff {
+		delay = delay << attempt
 
-	var body io.Reader
+		if c.apiRetryMaxDelaySeconds > 0 {
+			maxDelay := time.Duration(c.apiRetryMaxDelaySeconds) * time.Second
+			if delay > maxDelay || delay <= 0 {
+				delay = maxDelay
+			}
+		}
+	}
 
-	if requestBody != nil {
-		jsonData, err := json.Marshal(requestBody)
-		if err != nil {
-			errorMessage = fmt.Errorf("unable to marshal requestBody into json: %s", err.Error())
-			return
+	if c.apiRetryJitter && delay > 1 {
+		delay = delay/2 + rand.N(delay/2)
+	}
+
+	if httpResp != nil && (httpResp.StatusCode == http.StatusTooManyRequests || httpResp.StatusCode == http.StatusServiceUnavailable) {
+		if retryAfter, ok := parseRetryAfter(httpResp.Header.Get("Retry-After")); ok && retryAfter > delay {
+			delay = retryAfter
 		}
+	}
+
+	return delay
+}
+
+// Retry-After is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// The headers the provider sets itself to authenticate requests, which request_headers can't set.
+var reservedRequestHeaders = []string{"Authorization", "Content-Type", "Cookie", "X-CSRFToken"}
 
-		body = strings.NewReader(string(jsonData))
+// The page size requested when walking a list endpoint. The controller caps page_size at 200 by default,
+// so asking for the maximum keeps the number of round trips low while ListAPIRequest still follows `next`.
+const listPageSize = 200
+
+// A wrapper for GenericAPIRequest() that reads every page of a paginated list endpoint by following the
+// `next` link in each response. The returned body has the same shape as a single page (count + results),
+// but results holds the items from every page, so callers can unmarshal it the same way they would a single GET.
+func (c *providerClient) ListAPIRequest(ctx context.Context, url string, successCodes []int, aap25_api_endpoint_hint string) (responseBody []byte, statusCode int, errorMessage error) {
+	return c.ListAPIRequestLimit(ctx, url, 0, successCodes, aap25_api_endpoint_hint)
+}
+
+// Like ListAPIRequest(), but stops following `next` once limit results have been read, and returns at most
+// limit results. Pages are no larger than limit either. A limit of 0 or less reads every page.
+func (c *providerClient) ListAPIRequestLimit(ctx context.Context, url string, limit int64, successCodes []int, aap25_api_endpoint_hint string) (responseBody []byte, statusCode int, errorMessage error) {
+	pageSize := int64(listPageSize)
+	if limit > 0 {
+		pageSize = min(limit, pageSize)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	url, err := addPageSize(url, pageSize)
 	if err != nil {
-		errorMessage = fmt.Errorf("error generating http request: %v", err)
+		errorMessage = fmt.Errorf("unable to parse list url %s: %v", url, err)
 		return
 	}
-	httpReq.Header.Add("Content-Type", "application/json")
-	httpReq.Header.Add("Authorization", c.auth)
 
-	var success bool
-	var httpResp *http.Response
-	var maxAttempts int
+	allResults := make([]json.RawMessage, 0)
 
-	if method == http.MethodGet {
-		maxAttempts = 1 + int(c.apiRetryCount)
-	} else {
-		maxAttempts = 1
-	}
+	for url != "" {
+		var body []byte
 
-	for i := 0; i < maxAttempts; i++ {
-		httpResp, err = c.client.Do(httpReq)
-		if err != nil {
-			errorMessage = fmt.Errorf("error doing http request: %v", err)
+		body, statusCode, errorMessage = c.GenericAPIRequest(ctx, http.MethodGet, url, nil, successCodes, aap25_api_endpoint_hint)
+		if errorMessage != nil {
 			return
 		}
 
-		for _, successCode := range successCodes {
-			if httpResp.StatusCode == successCode {
-				success = true
-				break
-			}
+		// any non-200 code the caller accepted (i.e. 404) is handed back untouched for the caller to inspect
+		if statusCode != http.StatusOK {
+			responseBody = body
+			return
 		}
-		if success {
-			break
+
+		page := struct {
+			Next    *string           `json:"next"`
+			Results []json.RawMessage `json:"results"`
+		}{}
+
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			errorMessage = fmt.Errorf("unable to unmarshal list page from %s: %v", url, err)
+			return
 		}
 
-		// if not success & have remaining attempts
-		if !success && ((maxAttempts - i) > 1) {
-			SleepWithContext(ctx, time.Duration(c.apiRetryDelaySeconds)*time.Second)
+		allResults = append(allResults, page.Results...)
+
+		if limit > 0 && int64(len(allResults)) >= limit {
+			allResults = allResults[:limit]
+			break
 		}
 
+		url = ""
+		if page.Next != nil && *page.Next != "" {
+			url, err = c.trimAPIUrl(*page.Next, aap25_api_endpoint_hint)
+			if err != nil {
+				errorMessage = fmt.Errorf("unable to follow next page link %s: %v", *page.Next, err)
+				return
+			}
+		}
 	}
 
-	if !success {
-		body, err := io.ReadAll(httpResp.Body)
-		defer httpResp.Body.Close()
-		if err != nil {
-			errorMessage = errors.New("unable to read http request response body to retrieve error message")
-			return
-		}
-		errorMessage = fmt.Errorf("expected %v http response code for API call, got %d with message %s", successCodes, httpResp.StatusCode, body)
+	responseBody, err = json.Marshal(struct {
+		Count   int               `json:"count"`
+		Results []json.RawMessage `json:"results"`
+	}{
+		Count:   len(allResults),
+		Results: allResults,
+	})
+	if err != nil {
+		errorMessage = fmt.Errorf("unable to marshal combined list results: %v", err)
 		return
 	}
 
-	statusCode = httpResp.StatusCode
-	httpRespBodyData, err := io.ReadAll(httpResp.Body)
+	return
+}
+
+// Set page_size on a list url unless the caller already asked for a specific one.
+func addPageSize(resourceUrl string, pageSize int64) (string, error) {
+	parsedUrl, err := urlParser.Parse(resourceUrl)
 	if err != nil {
-		errorMessage = errors.New("unable to read http request response body to retrieve id")
-		return
+		return resourceUrl, err
 	}
-	err = json.Unmarshal(httpRespBodyData, &returnedData)
+
+	query := parsedUrl.Query()
+	if query.Get("page_size") == "" {
+		query.Set("page_size", fmt.Sprint(pageSize))
+	}
+	parsedUrl.RawQuery = query.Encode()
+
+	return parsedUrl.String(), nil
+}
+
+// The controller returns `next` as a path from the server root (i.e. /api/v2/hosts/?page=2) or, behind some
+// proxies, as an absolute URL. Strip everything up to and including the api prefix so that the result can be
+// passed back into GenericAPIRequest(), which prepends the endpoint and prefix itself.
+func (c *providerClient) trimAPIUrl(link, aap25_api_endpoint_hint string) (string, error) {
+	parsedUrl, err := urlParser.Parse(link)
 	if err != nil {
-		errorMessage = errors.New("unable to unmarshal http request response body to retrieve returnedData")
-		return
+		return "", err
 	}
-	return
+
+	prefix := c.urlPrefix
+	if aap25_api_endpoint_hint == "gateway" && c.platform == platformAAP {
+		prefix = gatewayAPIPrefix
+	}
+
+	index := strings.Index(parsedUrl.Path, prefix)
+	if index < 0 {
+		return "", fmt.Errorf("link path does not contain the api prefix %s", prefix)
+	}
+
+	resourceUrl := parsedUrl.Path[index+len(prefix):]
+	if parsedUrl.RawQuery != "" {
+		resourceUrl += "?" + parsedUrl.RawQuery
+	}
+
+	return resourceUrl, nil
 }
 
 // In AAP, most api endpoint live in /controller/. But, sometimes they specifyc gateway endpoint instead.
 func (c *providerClient) buildAPIUrl(resourceUrl, aap25_api_endpoint_hint string) (url string) {
 
-	if aap25_api_endpoint_hint == "gateway" && configprefix.Prefix == "aap" {
-		url = c.endpoint + "/api/gateway/v1/" + resourceUrl
+	if aap25_api_endpoint_hint == "gateway" && c.platform == platformAAP {
+		url = c.endpoint + gatewayAPIPrefix + resourceUrl
 	} else {
 		url = c.endpoint + c.urlPrefix + resourceUrl
 	}
@@ -0,0 +1,436 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	urlParser "net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
+)
+
+// How the provider authenticates with a username and password. authMethodBasic sends them with every request.
+// The other methods log in once, use the resulting session or token for every request of the run and log in
+// again whenever the controller answers 401.
+const (
+	authMethodBasic   = "basic"
+	authMethodSession = "session"
+	authMethodOAuth2  = "oauth2"
+)
+
+// The login state of a providerClient using authMethodSession or authMethodOAuth2.
+type loginConfig struct {
+	method             string
+	username           string
+	password           string
+	oauth2ClientID     string
+	oauth2ClientSecret string
+	revokeOnShutdown   bool
+
+	// set by the last successful login, and used to undo it at shutdown
+	accessToken string
+	tokenID     int64
+}
+
+// Clients whose login should be undone when the plugin process exits, see Shutdown().
+var shutdownClients struct {
+	sync.Mutex
+	clients []*providerClient
+}
+
+// How long main gives Shutdown. When Terraform is done with the plugin, go-plugin kills the process about 2
+// seconds after asking it to stop, so revocation has to finish well within that.
+const ShutdownTimeout = 1500 * time.Millisecond
+
+// Shutdown revokes the tokens and ends the sessions created by every provider configured with
+// revoke_on_shutdown. It's called by main once the plugin server has stopped. The logins are undone
+// concurrently, so one slow controller doesn't use up the time the others have.
+func Shutdown(ctx context.Context) {
+	shutdownClients.Lock()
+	clients := shutdownClients.clients
+	shutdownClients.clients = nil
+	shutdownClients.Unlock()
+
+	var wg sync.WaitGroup
+	for _, client := range clients {
+		wg.Go(func() {
+			if err := client.logout(ctx); err != nil {
+				tflog.Warn(ctx, "Unable to revoke automation controller login", map[string]any{
+					"endpoint": client.endpoint,
+					"error":    err.Error(),
+				})
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// Returns the Authorization header to send and the login generation it belongs to, so a request answered with
+// 401 can tell whether another request already logged in again.
+func (c *providerClient) currentAuth() (string, int) {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+
+	return c.auth, c.authGeneration
+}
+
+func (c *providerClient) setAuth(auth string) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.auth = auth
+	c.authGeneration++
+}
+
+// Adds the CSRF token and referer Django requires on requests made with a session cookie.
+func (c *providerClient) setSessionHeaders(httpReq *http.Request) {
+	if c.login == nil || c.login.method != authMethodSession || c.client.Jar == nil {
+		return
+	}
+
+	for _, cookie := range c.client.Jar.Cookies(httpReq.URL) {
+		if cookie.Name == "csrftoken" {
+			httpReq.Header.Set("X-CSRFToken", cookie.Value)
+		}
+	}
+	httpReq.Header.Set("Referer", c.endpoint+"/")
+}
+
+// Logs in with the configured method. Must be called after setPlatform(), as the login urls differ between
+// AWX and AAP 2.5+.
+func (c *providerClient) authenticate(ctx context.Context) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	return c.authenticateLocked(ctx)
+}
+
+// Logs in again after a request made with login generation was answered with 401. When several requests fail
+// at once only the first one logs in; the others just retry with the new session or token.
+func (c *providerClient) reauthenticate(ctx context.Context, generation int) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	if _, current := c.currentAuth(); current != generation {
+		return nil
+	}
+
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Logging in again after a 401 response", map[string]any{
+		"auth_method": c.login.method,
+	})
+
+	return c.authenticateLocked(ctx)
+}
+
+func (c *providerClient) authenticateLocked(ctx context.Context) error {
+	if c.login == nil {
+		return nil
+	}
+
+	previousToken, previousTokenID := c.login.accessToken, c.login.tokenID
+
+	var err error
+	switch {
+	case c.login.method == authMethodSession:
+		err = c.sessionLogin(ctx)
+	case c.login.oauth2ClientID != "":
+		err = c.oauth2Login(ctx)
+	default:
+		err = c.personalTokenLogin(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Shutdown only revokes the last token, so one replaced after a 401 is revoked here rather than left valid
+	// until it expires. The new login is already in place, so failing to revoke the old token is only logged.
+	if c.login.revokeOnShutdown && previousToken != "" && previousToken != c.login.accessToken {
+		method, url, body, header := c.tokenRevocationRequest(previousToken, previousTokenID)
+		if err := c.logoutRequest(ctx, method, url, body, header); err != nil {
+			tflog.Warn(ctx, "Unable to revoke the automation controller token replaced by logging in again", map[string]any{
+				"endpoint": c.endpoint,
+				"error":    err.Error(),
+			})
+		}
+	}
+
+	return nil
+}
+
+// The Django login page of the platform: the gateway's on AAP 2.5+, the controller's otherwise.
+func (c *providerClient) sessionURL(page string) string {
+	if c.platform == platformAAP {
+		return c.endpoint + gatewayAPIPrefix + page + "/"
+	}
+
+	return c.endpoint + "/api/" + page + "/"
+}
+
+// The OAuth2 provider endpoints: served by the gateway at /o/ on AAP 2.5+, and at /api/o/ on AWX.
+func (c *providerClient) oauth2URL(page string) string {
+	if c.platform == platformAAP {
+		return c.endpoint + "/o/" + page + "/"
+	}
+
+	return c.endpoint + "/api/o/" + page + "/"
+}
+
+// Fetches the login page for a CSRF cookie, then posts the credentials to it. The session cookie is kept in the
+// http.Client's cookie jar, so no Authorization header is sent afterwards.
+func (c *providerClient) sessionLogin(ctx context.Context) error {
+	if c.client.Jar == nil {
+		return errors.New("session authentication requires a cookie jar")
+	}
+
+	loginURL := c.sessionURL("login")
+
+	_, statusCode, err := c.loginRequest(ctx, http.MethodGet, loginURL, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("expected [200] http response code for %s, got %d", loginURL, statusCode)
+	}
+
+	form := urlParser.Values{
+		"username": {c.login.username},
+		"password": {c.login.password},
+	}
+
+	header := http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}
+	parsedLoginURL, err := urlParser.Parse(loginURL)
+	if err != nil {
+		return err
+	}
+	for _, cookie := range c.client.Jar.Cookies(parsedLoginURL) {
+		if cookie.Name == "csrftoken" {
+			header.Set("X-CSRFToken", cookie.Value)
+		}
+	}
+	header.Set("Referer", loginURL)
+
+	_, statusCode, err = c.loginRequest(ctx, http.MethodPost, loginURL, strings.NewReader(form.Encode()), header)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK && statusCode != http.StatusFound {
+		return fmt.Errorf("expected [200 302] http response code for %s, got %d", loginURL, statusCode)
+	}
+
+	// a rejected login re-renders the form with a 200, so the session cookie is the only reliable signal
+	for _, cookie := range c.client.Jar.Cookies(parsedLoginURL) {
+		if strings.HasSuffix(cookie.Name, "sessionid") {
+			c.setAuth("")
+			return nil
+		}
+	}
+
+	return fmt.Errorf("login as %s was rejected by %s", c.login.username, loginURL)
+}
+
+// Exchanges the username and password for an access token with the OAuth2 password grant of the configured
+// application.
+func (c *providerClient) oauth2Login(ctx context.Context) error {
+	form := urlParser.Values{
+		"grant_type": {"password"},
+		"username":   {c.login.username},
+		"password":   {c.login.password},
+		"scope":      {"write"},
+	}
+
+	header := http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}
+	c.setOAuth2ClientAuth(form, header)
+
+	tokenURL := c.oauth2URL("token")
+
+	body, statusCode, err := c.loginRequest(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()), header)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("expected [200] http response code for %s, got %d with message %s", tokenURL, statusCode, body)
+	}
+
+	tokenResponse := struct {
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil || tokenResponse.AccessToken == "" {
+		return fmt.Errorf("no access_token in the response from %s", tokenURL)
+	}
+
+	c.login.accessToken = tokenResponse.AccessToken
+	c.setAuth("Bearer " + tokenResponse.AccessToken)
+
+	return nil
+}
+
+// Creates a personal access token for the user, authenticating that one request with HTTP Basic.
+func (c *providerClient) personalTokenLogin(ctx context.Context) error {
+	requestBody, err := json.Marshal(map[string]string{
+		"description": fmt.Sprintf("Terraform provider %s (%s)", configprefix.Prefix, time.Now().UTC().Format(time.RFC3339)),
+		"scope":       "write",
+	})
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{
+		"Content-Type":  {"application/json"},
+		"Authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte(c.login.username+":"+c.login.password))},
+	}
+
+	tokensURL := c.buildAPIUrl("tokens/", "gateway")
+
+	body, statusCode, err := c.loginRequest(ctx, http.MethodPost, tokensURL, strings.NewReader(string(requestBody)), header)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusCreated {
+		return fmt.Errorf("expected [201] http response code for %s, got %d with message %s", tokensURL, statusCode, body)
+	}
+
+	tokenResponse := struct {
+		ID    int64  `json:"id"`
+		Token string `json:"token"`
+	}{}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil || tokenResponse.Token == "" {
+		return fmt.Errorf("no token in the response from %s", tokensURL)
+	}
+
+	c.login.accessToken = tokenResponse.Token
+	c.login.tokenID = tokenResponse.ID
+	c.setAuth("Bearer " + tokenResponse.Token)
+
+	return nil
+}
+
+// Confidential applications authenticate with HTTP Basic, public ones just name themselves in the form.
+func (c *providerClient) setOAuth2ClientAuth(form urlParser.Values, header http.Header) {
+	if c.login.oauth2ClientSecret == "" {
+		form.Set("client_id", c.login.oauth2ClientID)
+		return
+	}
+
+	credentials := urlParser.QueryEscape(c.login.oauth2ClientID) + ":" + urlParser.QueryEscape(c.login.oauth2ClientSecret)
+	header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+}
+
+// Ends the session or revokes the token created by the last login.
+func (c *providerClient) logout(ctx context.Context) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	if c.login == nil {
+		return nil
+	}
+
+	var (
+		method, url string
+		body        io.Reader
+		header      http.Header
+	)
+
+	switch {
+	case c.login.method == authMethodSession:
+		method, url, header = http.MethodPost, c.sessionURL("logout"), http.Header{}
+		parsedURL, err := urlParser.Parse(url)
+		if err != nil {
+			return err
+		}
+		for _, cookie := range c.client.Jar.Cookies(parsedURL) {
+			if cookie.Name == "csrftoken" {
+				header.Set("X-CSRFToken", cookie.Value)
+			}
+		}
+		header.Set("Referer", c.endpoint+"/")
+	case c.login.accessToken == "":
+		return nil
+	default:
+		method, url, body, header = c.tokenRevocationRequest(c.login.accessToken, c.login.tokenID)
+	}
+
+	if err := c.logoutRequest(ctx, method, url, body, header); err != nil {
+		return err
+	}
+
+	c.login.accessToken = ""
+	c.setAuth("")
+
+	return nil
+}
+
+// Builds the request revoking a token created by oauth2Login() or personalTokenLogin(). Personal access tokens
+// are deleted with the current token, which is still valid when the one being revoked has been rejected.
+func (c *providerClient) tokenRevocationRequest(token string, tokenID int64) (string, string, io.Reader, http.Header) {
+	header := http.Header{}
+
+	if c.login.oauth2ClientID != "" {
+		form := urlParser.Values{"token": {token}}
+		header.Set("Content-Type", "application/x-www-form-urlencoded")
+		c.setOAuth2ClientAuth(form, header)
+		return http.MethodPost, c.oauth2URL("revoke_token"), strings.NewReader(form.Encode()), header
+	}
+
+	header.Set("Authorization", "Bearer "+c.login.accessToken)
+	return http.MethodDelete, c.buildAPIUrl(fmt.Sprintf("tokens/%d/", tokenID), "gateway"), nil, header
+}
+
+// Sends a logout or revocation request, which any successful response code completes.
+func (c *providerClient) logoutRequest(ctx context.Context, method, url string, body io.Reader, header http.Header) error {
+	_, statusCode, err := c.loginRequest(ctx, method, url, body, header)
+	if err != nil {
+		return err
+	}
+	if statusCode >= http.StatusBadRequest {
+		return fmt.Errorf("expected a successful http response code for %s, got %d", url, statusCode)
+	}
+
+	return nil
+}
+
+// Sends a request that is part of logging in or out. These bypass doAPIRequest(), so they are never retried,
+// never trigger another login, and their bodies, which hold credentials, are never logged.
+func (c *providerClient) loginRequest(ctx context.Context, method, url string, body io.Reader, header http.Header) ([]byte, int, error) {
+	ctx = apiLogContext(ctx)
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error generating http request: %v", err)
+	}
+	for name, values := range c.headers {
+		httpReq.Header.Set(name, values)
+	}
+	for name, values := range header {
+		httpReq.Header[name] = values
+	}
+
+	start := time.Now()
+
+	httpResp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error doing http request: %v", err)
+	}
+	defer httpResp.Body.Close()
+
+	responseBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, httpResp.StatusCode, fmt.Errorf("unable to read the http response data body: %v", err)
+	}
+
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Authentication request completed", map[string]any{
+		"method":      method,
+		"url":         url,
+		"status_code": httpResp.StatusCode,
+		"latency_ms":  time.Since(start).Milliseconds(),
+	})
+
+	return responseBody, httpResp.StatusCode, nil
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPersonalTokenLogin(t *testing.T) {
+	var logins atomic.Int32
+	var revoked []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/tokens/":
+			if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			login := logins.Add(1)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": %d, "token": "token-%d"}`, login, login)
+		case r.Method == http.MethodDelete:
+			// the expired token can't authenticate its own revocation
+			if r.Header.Get("Authorization") != "Bearer token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			revoked = append(revoked, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/v2/me/":
+			// the first token expires straight away
+			if r.Header.Get("Authorization") != "Bearer token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"count": 1, "results": []}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:   server.Client(),
+		endpoint: server.URL,
+		login:    &loginConfig{method: authMethodOAuth2, username: "admin", password: "secret", revokeOnShutdown: true},
+	}
+	client.setPlatform(platformAWX)
+
+	if err := client.authenticate(context.Background()); err != nil {
+		t.Fatalf("unexpected login error: %v", err)
+	}
+
+	if _, _, err := client.GenericAPIRequest(context.Background(), http.MethodGet, "me/", nil, []int{200}, ""); err != nil {
+		t.Fatalf("expected the request to succeed after logging in again, got: %v", err)
+	}
+	if logins.Load() != 2 {
+		t.Errorf("expected 2 logins, got %d", logins.Load())
+	}
+	if !slices.Equal(revoked, []string{"/api/v2/tokens/1/"}) {
+		t.Errorf("expected the replaced token to be revoked when logging in again, got revocations of %v", revoked)
+	}
+
+	if err := client.logout(context.Background()); err != nil {
+		t.Fatalf("unexpected logout error: %v", err)
+	}
+	if !slices.Equal(revoked, []string{"/api/v2/tokens/1/", "/api/v2/tokens/2/"}) {
+		t.Errorf("expected the current token to be revoked, got revocations of %v", revoked)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	var revoked atomic.Int32
+
+	// each revocation alone takes most of the shutdown window
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		time.Sleep(ShutdownTimeout / 2)
+		revoked.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	for i := range 3 {
+		client := &providerClient{
+			client:   server.Client(),
+			endpoint: server.URL,
+			login:    &loginConfig{method: authMethodOAuth2, accessToken: fmt.Sprintf("token-%d", i), tokenID: int64(i + 1)},
+		}
+		client.setPlatform(platformAWX)
+
+		shutdownClients.Lock()
+		shutdownClients.clients = append(shutdownClients.clients, client)
+		shutdownClients.Unlock()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+
+	Shutdown(ctx)
+
+	if revoked.Load() != 3 {
+		t.Errorf("expected every login to be revoked within the shutdown timeout, got %d revocations", revoked.Load())
+	}
+}
+
+func TestOAuth2PasswordGrantLogin(t *testing.T) {
+	var revokedToken string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "app" || clientSecret != "app-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/o/token/":
+			if r.PostForm.Get("grant_type") != "password" || r.PostForm.Get("username") != "admin" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"access_token": "access", "token_type": "Bearer", "expires_in": 36000}`)
+		case "/o/revoke_token/":
+			revokedToken = r.PostForm.Get("token")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:   server.Client(),
+		endpoint: server.URL,
+		login: &loginConfig{
+			method:             authMethodOAuth2,
+			username:           "admin",
+			password:           "secret",
+			oauth2ClientID:     "app",
+			oauth2ClientSecret: "app-secret",
+		},
+	}
+	client.setPlatform(platformAAP)
+
+	if err := client.authenticate(context.Background()); err != nil {
+		t.Fatalf("unexpected login error: %v", err)
+	}
+	if auth, _ := client.currentAuth(); auth != "Bearer access" {
+		t.Errorf("unexpected auth header: %s", auth)
+	}
+
+	if err := client.logout(context.Background()); err != nil {
+		t.Fatalf("unexpected logout error: %v", err)
+	}
+	if revokedToken != "access" {
+		t.Errorf("expected the access token to be revoked, got: %q", revokedToken)
+	}
+}
+
+func TestSessionLogin(t *testing.T) {
+	var sessions atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/gateway/v1/login/" && r.Method == http.MethodGet:
+			http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: "csrf", Path: "/"})
+		case r.URL.Path == "/api/gateway/v1/login/" && r.Method == http.MethodPost:
+			if r.Header.Get("X-CSRFToken") != "csrf" || r.FormValue("password") != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			session := sessions.Add(1)
+			http.SetCookie(w, &http.Cookie{Name: "gateway_sessionid", Value: fmt.Sprintf("session-%d", session), Path: "/"})
+		case r.URL.Path == "/api/controller/v2/job_templates/1/":
+			cookie, err := r.Cookie("gateway_sessionid")
+			if err != nil || cookie.Value != "session-2" || r.Header.Get("Authorization") != "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.Header.Get("X-CSRFToken") != "csrf" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	httpClient.Jar, _ = cookiejar.New(nil)
+
+	client := &providerClient{
+		client:   httpClient,
+		endpoint: server.URL,
+		login:    &loginConfig{method: authMethodSession, username: "admin", password: "secret"},
+	}
+	client.setPlatform(platformAAP)
+
+	if err := client.authenticate(context.Background()); err != nil {
+		t.Fatalf("unexpected login error: %v", err)
+	}
+
+	if _, _, err := client.CreateUpdateAPIRequest(context.Background(), http.MethodPatch, "job_templates/1/", map[string]any{"name": "x"}, []int{200}, ""); err != nil {
+		t.Fatalf("expected the request to succeed with a renewed session, got: %v", err)
+	}
+	if sessions.Load() != 2 {
+		t.Errorf("expected 2 logins, got %d", sessions.Load())
+	}
+}
+
+func TestSessionLogin_rejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a rejected login just renders the form again
+		http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: "csrf", Path: "/"})
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	httpClient.Jar, _ = cookiejar.New(nil)
+
+	client := &providerClient{
+		client:   httpClient,
+		endpoint: server.URL,
+		login:    &loginConfig{method: authMethodSession, username: "admin", password: "wrong"},
+	}
+	client.setPlatform(platformAWX)
+
+	if err := client.authenticate(context.Background()); err == nil {
+		t.Fatal("expected an error when no session cookie is set")
+	}
+}
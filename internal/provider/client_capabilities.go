@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// A feature of the automation controller that only some platforms or versions support. The value is used in
+// diagnostics, so it reads as the name of the feature.
+type controllerCapability string
+
+const (
+	capabilityOrganizationDefaultEnvironment controllerCapability = "organization default_environment"
+	capabilityOrganizationMaxHosts           controllerCapability = "organization max_hosts"
+	capabilityUserSystemAuditor              controllerCapability = "user is_system_auditor"
+	capabilityRoleAssignments                controllerCapability = "role definitions and role assignments"
+)
+
+// The first AWX and AAP controller versions that ship the DAB RBAC role_definitions and role assignment APIs.
+var (
+	roleAssignmentsMinAWXVersion        = []int{24, 0, 0}
+	roleAssignmentsMinControllerVersion = []int{4, 6, 0}
+)
+
+// What the provider learned about the controller from /ping/ and /config/ when it was configured.
+type controllerInfo struct {
+	Version     string
+	LicenseType string
+
+	capabilities map[controllerCapability]bool
+}
+
+// Reads the controller version and license type once, and works out the capability set from them and the
+// platform. Must be called after setPlatform(). When the version can't be read, every version dependent
+// capability is assumed to be supported so that a failed lookup never blocks a plan.
+func (c *providerClient) discoverController(ctx context.Context) error {
+	c.controller.capabilities = map[controllerCapability]bool{
+		capabilityOrganizationDefaultEnvironment: c.platform != platformAAP,
+		capabilityOrganizationMaxHosts:           c.platform != platformAAP,
+		capabilityUserSystemAuditor:              c.platform != platformAAP,
+		capabilityRoleAssignments:                true,
+	}
+
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, "ping/", nil, []int{200}, "")
+	if err != nil {
+		return fmt.Errorf("unable to read ping/: %v", err)
+	}
+
+	ping := struct {
+		Version string `json:"version"`
+	}{}
+	if err := json.Unmarshal(body, &ping); err != nil {
+		return fmt.Errorf("unable to unmarshal ping/ response: %v", err)
+	}
+	c.controller.Version = ping.Version
+
+	body, _, err = c.GenericAPIRequest(ctx, http.MethodGet, "config/", nil, []int{200}, "")
+	if err != nil {
+		return fmt.Errorf("unable to read config/: %v", err)
+	}
+
+	config := struct {
+		Version     string `json:"version"`
+		LicenseInfo struct {
+			LicenseType string `json:"license_type"`
+		} `json:"license_info"`
+	}{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return fmt.Errorf("unable to unmarshal config/ response: %v", err)
+	}
+	if c.controller.Version == "" {
+		c.controller.Version = config.Version
+	}
+	c.controller.LicenseType = config.LicenseInfo.LicenseType
+
+	version, ok := parseControllerVersion(c.controller.Version)
+	if !ok {
+		return fmt.Errorf("unable to parse controller version %q", c.controller.Version)
+	}
+
+	minVersion := roleAssignmentsMinControllerVersion
+	if c.controller.isAWX() {
+		minVersion = roleAssignmentsMinAWXVersion
+	}
+	c.controller.capabilities[capabilityRoleAssignments] = compareVersions(version, minVersion) >= 0
+
+	return nil
+}
+
+// AWX reports an open source license. AWX versions also start at 9, well above any AAP controller release,
+// which is used when the license type isn't available.
+func (i controllerInfo) isAWX() bool {
+	if i.LicenseType != "" {
+		return i.LicenseType == "open"
+	}
+
+	version, ok := parseControllerVersion(i.Version)
+	return ok && version[0] >= 9
+}
+
+// A description of the detected controller for diagnostics, i.e. "AWX 24.6.1".
+func (c *providerClient) controllerDescription() string {
+	version := c.controller.Version
+	if version == "" {
+		version = "(unknown version)"
+	}
+
+	switch {
+	case c.platform == platformAAP:
+		return "AAP 2.5+ controller " + version
+	case c.controller.isAWX():
+		return "AWX " + version
+	default:
+		return "automation controller " + version
+	}
+}
+
+func (c *providerClient) supports(capability controllerCapability) bool {
+	supported, ok := c.controller.capabilities[capability]
+	return !ok || supported
+}
+
+// Adds an error to diags when the controller doesn't support capability. attributePath points the error at the
+// offending attribute; pass path.Empty() when the whole resource is unsupported.
+func (c *providerClient) requireCapability(diags *diag.Diagnostics, capability controllerCapability, attributePath path.Path) {
+	if c.supports(capability) {
+		return
+	}
+
+	detail := fmt.Sprintf("The %s feature is not supported by the detected %s.", capability, c.controllerDescription())
+
+	if attributePath.Equal(path.Empty()) {
+		diags.AddError("Unsupported Automation Controller Feature", detail)
+		return
+	}
+
+	diags.AddAttributeError(attributePath, "Invalid Attribute Configuration", detail)
+}
+
+// Parses the leading major.minor.patch of a version such as "24.6.1" or "4.6.2.dev0+g1a2b3c". Missing minor or
+// patch components are treated as 0.
+func parseControllerVersion(version string) ([]int, bool) {
+	parsed := make([]int, 3)
+
+	parts := strings.SplitN(version, ".", 4)
+	for i := 0; i < len(parts) && i < 3; i++ {
+		digits := parts[i]
+		if end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+			digits = digits[:end]
+		}
+
+		number, err := strconv.Atoi(digits)
+		if err != nil {
+			if i == 0 {
+				return nil, false
+			}
+			break
+		}
+		parsed[i] = number
+	}
+
+	return parsed, true
+}
+
+func compareVersions(a, b []int) int {
+	for i := range min(len(a), len(b)) {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
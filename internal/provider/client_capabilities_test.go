@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDiscoverController(t *testing.T) {
+	testCases := map[string]struct {
+		platform        string
+		version         string
+		licenseType     string
+		roleAssignments bool
+		maxHosts        bool
+	}{
+		"awx with dab rbac":         {platform: platformAWX, version: "24.6.1", licenseType: "open", roleAssignments: true, maxHosts: true},
+		"awx before dab rbac":       {platform: platformAWX, version: "23.9.0", licenseType: "open", roleAssignments: false, maxHosts: true},
+		"aap 2.4 controller":        {platform: platformAWX, version: "4.5.8", licenseType: "enterprise", roleAssignments: false, maxHosts: true},
+		"aap 2.5 controller":        {platform: platformAAP, version: "4.6.2", licenseType: "enterprise", roleAssignments: true, maxHosts: false},
+		"awx dev build":             {platform: platformAWX, version: "24.6.2.dev0+g1a2b3c", licenseType: "open", roleAssignments: true, maxHosts: true},
+		"awx without license info":  {platform: platformAWX, version: "22.0.0", roleAssignments: false, maxHosts: true},
+		"controller without patch":  {platform: platformAWX, version: "4.6", licenseType: "enterprise", roleAssignments: true, maxHosts: true},
+		"unparseable version stays": {platform: platformAWX, version: "devel", licenseType: "open", roleAssignments: true, maxHosts: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/ping/"):
+					fmt.Fprintf(w, `{"version": %q}`, testCase.version)
+				case strings.HasSuffix(r.URL.Path, "/config/"):
+					fmt.Fprintf(w, `{"version": %q, "license_info": {"license_type": %q}}`, testCase.version, testCase.licenseType)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := &providerClient{
+				client:   server.Client(),
+				endpoint: server.URL,
+			}
+			client.setPlatform(testCase.platform)
+
+			// an unparseable version is reported, but must leave the capabilities permissive
+			_ = client.discoverController(context.Background())
+
+			if client.controller.Version != testCase.version {
+				t.Errorf("expected version %q, got %q", testCase.version, client.controller.Version)
+			}
+			if got := client.supports(capabilityRoleAssignments); got != testCase.roleAssignments {
+				t.Errorf("expected role assignments support to be %t, got %t", testCase.roleAssignments, got)
+			}
+			if got := client.supports(capabilityOrganizationMaxHosts); got != testCase.maxHosts {
+				t.Errorf("expected max_hosts support to be %t, got %t", testCase.maxHosts, got)
+			}
+		})
+	}
+}
+
+func TestDiscoverController_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client := &providerClient{
+		client:   server.Client(),
+		endpoint: server.URL,
+	}
+	client.setPlatform(platformAAP)
+
+	if err := client.discoverController(context.Background()); err == nil {
+		t.Fatal("expected an error when ping/ can't be read")
+	}
+
+	if !client.supports(capabilityRoleAssignments) {
+		t.Error("expected version dependent capabilities to be assumed supported")
+	}
+	if client.supports(capabilityUserSystemAuditor) {
+		t.Error("expected platform dependent capabilities to still apply")
+	}
+}
+
+func TestRequireCapability(t *testing.T) {
+	client := &providerClient{
+		platform: platformAWX,
+		controller: controllerInfo{
+			Version:     "23.9.0",
+			LicenseType: "open",
+			capabilities: map[controllerCapability]bool{
+				capabilityRoleAssignments:      false,
+				capabilityOrganizationMaxHosts: true,
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	client.requireCapability(&diags, capabilityOrganizationMaxHosts, path.Root("max_hosts"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	client.requireCapability(&diags, capabilityRoleAssignments, path.Empty())
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected one error, got: %v", diags)
+	}
+
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "AWX 23.9.0") {
+		t.Errorf("expected the detected version in the diagnostic, got: %s", detail)
+	}
+}
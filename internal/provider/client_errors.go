@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Keys the controller uses for errors that aren't about a single request field.
+var apiNonFieldErrorKeys = []string{"__all__", "non_field_errors", "detail", "error", "msg"}
+
+// Returned by the client when the controller answers with a status code the caller didn't expect. For
+// validation failures (i.e. `{"playbook": ["Playbook not found for project."]}`) the messages are parsed
+// into FieldErrors, keyed by the request field. Errors nested inside an object field, such as a credential's
+// inputs, are keyed with a dotted path, i.e. "inputs.password".
+type APIError struct {
+	StatusCode     int
+	SuccessCodes   []int
+	Body           []byte
+	FieldErrors    map[string][]string
+	NonFieldErrors []string
+}
+
+func newAPIError(statusCode int, successCodes []int, body []byte) *APIError {
+	apiError := &APIError{
+		StatusCode:   statusCode,
+		SuccessCodes: successCodes,
+		Body:         body,
+		FieldErrors:  map[string][]string{},
+	}
+
+	// only a 400 carries validation errors; any other body is just kept for the error message
+	var decoded map[string]any
+	if statusCode != http.StatusBadRequest || json.Unmarshal(body, &decoded) != nil {
+		return apiError
+	}
+
+	for key, value := range decoded {
+		if slices.Contains(apiNonFieldErrorKeys, key) {
+			apiError.NonFieldErrors = append(apiError.NonFieldErrors, flattenErrorMessages(value)...)
+			continue
+		}
+		apiError.addFieldErrors(key, value)
+	}
+
+	slices.Sort(apiError.NonFieldErrors)
+
+	return apiError
+}
+
+func (e *APIError) addFieldErrors(field string, value any) {
+	if nested, ok := value.(map[string]any); ok {
+		for key, child := range nested {
+			e.addFieldErrors(field+"."+key, child)
+		}
+		return
+	}
+
+	if messages := flattenErrorMessages(value); len(messages) > 0 {
+		e.FieldErrors[field] = append(e.FieldErrors[field], messages...)
+	}
+}
+
+func flattenErrorMessages(value any) (messages []string) {
+	switch typed := value.(type) {
+	case string:
+		messages = append(messages, typed)
+	case []any:
+		for _, item := range typed {
+			messages = append(messages, flattenErrorMessages(item)...)
+		}
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(typed)) {
+			for _, message := range flattenErrorMessages(typed[key]) {
+				messages = append(messages, key+": "+message)
+			}
+		}
+	case nil:
+	default:
+		messages = append(messages, fmt.Sprint(typed))
+	}
+
+	return
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("expected %v http response code for API call, got %d with message %s", e.SuccessCodes, e.StatusCode, e.Body)
+}
+
+// Maps the tfsdk attribute names of a resource model to schema paths. The controller names request fields the
+// same way the schemas in this provider name their attributes, so this is the mapping addAPIErrorDiagnostics()
+// needs for most resources.
+func apiFieldPaths(model any) map[string]path.Path {
+	fieldPaths := map[string]path.Path{}
+
+	modelType := reflect.TypeOf(model)
+	for i := range modelType.NumField() {
+		tag := modelType.Field(i).Tag.Get("tfsdk")
+		if tag != "" && tag != "-" {
+			fieldPaths[tag] = path.Root(tag)
+		}
+	}
+
+	return fieldPaths
+}
+
+// Points the controller's errors for field at mapAttribute when the object form of a variables attribute is
+// configured, i.e. extra_vars_map rather than extra_vars, so the diagnostic names the argument that was set.
+func withVariablesMapFieldPath(fieldPaths map[string]path.Path, field, mapAttribute string, variablesMap types.Dynamic) map[string]path.Path {
+	if variablesMap.IsNull() {
+		return fieldPaths
+	}
+
+	remapped := maps.Clone(fieldPaths)
+	remapped[field] = path.Root(mapAttribute)
+
+	return remapped
+}
+
+// Adds the error from an API call to diags. When the controller rejected individual request fields that map
+// onto an attribute in fieldPaths, each message is added as an attribute error so Terraform points at the
+// offending argument. Everything else is added as a regular error.
+func addAPIErrorDiagnostics(diags *diag.Diagnostics, summary string, err error, fieldPaths map[string]path.Path) {
+	var apiError *APIError
+	if !errors.As(err, &apiError) || len(apiError.FieldErrors) == 0 {
+		diags.AddError(summary, fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	var unmapped []string
+
+	for _, field := range slices.Sorted(maps.Keys(apiError.FieldErrors)) {
+		messages := apiError.FieldErrors[field]
+
+		// nested errors are reported against the closest attribute, naming the nested key in the message
+		attribute, nestedKey, _ := strings.Cut(field, ".")
+
+		attributePath, ok := fieldPaths[attribute]
+		if !ok {
+			for _, message := range messages {
+				unmapped = append(unmapped, fmt.Sprintf("%s: %s", field, message))
+			}
+			continue
+		}
+
+		for _, message := range messages {
+			if nestedKey != "" {
+				message = fmt.Sprintf("%s: %s", nestedKey, message)
+			}
+			diags.AddAttributeError(attributePath, summary, fmt.Sprintf("The automation controller rejected this value: %s", message))
+		}
+	}
+
+	unmapped = append(unmapped, apiError.NonFieldErrors...)
+	if len(unmapped) > 0 {
+		diags.AddError(summary, fmt.Sprintf("The automation controller returned status %d: %s.", apiError.StatusCode, strings.Join(unmapped, "; ")))
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewAPIError_fieldErrors(t *testing.T) {
+	body := []byte(`{
+		"playbook": ["Playbook not found for project."],
+		"inputs": {"password": ["This field is required."]},
+		"__all__": ["Credential type does not exist."]
+	}`)
+
+	apiError := newAPIError(http.StatusBadRequest, []int{201}, body)
+
+	if got := apiError.FieldErrors["playbook"]; len(got) != 1 || got[0] != "Playbook not found for project." {
+		t.Errorf("unexpected playbook errors: %v", got)
+	}
+
+	if got := apiError.FieldErrors["inputs.password"]; len(got) != 1 || got[0] != "This field is required." {
+		t.Errorf("unexpected nested inputs errors: %v", got)
+	}
+
+	if len(apiError.NonFieldErrors) != 1 || apiError.NonFieldErrors[0] != "Credential type does not exist." {
+		t.Errorf("unexpected non-field errors: %v", apiError.NonFieldErrors)
+	}
+
+	expected := `expected [201] http response code for API call, got 400 with message ` + string(body)
+	if apiError.Error() != expected {
+		t.Errorf("expected error message to be unchanged from previous releases, got %q", apiError.Error())
+	}
+}
+
+func TestAddAPIErrorDiagnostics(t *testing.T) {
+	apiError := newAPIError(http.StatusBadRequest, []int{201}, []byte(`{"playbook": ["Playbook not found for project."], "unknown_field": ["bad"]}`))
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(&diags, "Error making API http request", apiError, apiFieldPaths(JobTemplateModel{}))
+
+	if len(diags) != 2 {
+		t.Fatalf("expected one attribute error and one general error, got %d: %v", len(diags), diags)
+	}
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("playbook")) {
+		t.Errorf("expected first diagnostic to point at playbook, got %v", diags[0])
+	}
+
+	if _, ok := diags[1].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected unknown field to be reported without a path, got %v", diags[1])
+	}
+}
+
+func TestAddAPIErrorDiagnostics_otherErrors(t *testing.T) {
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(&diags, "Error making API http request", errors.New("error doing http request: connection refused"), nil)
+
+	if len(diags) != 1 || diags[0].Detail() != "Error was: error doing http request: connection refused." {
+		t.Fatalf("expected errors without field details to be reported as before, got %v", diags)
+	}
+}
+
+func TestAddAPIErrorDiagnostics_variablesMap(t *testing.T) {
+	apiError := newAPIError(http.StatusBadRequest, []int{201}, []byte(`{"variables": ["Cannot parse as JSON or YAML."]}`))
+
+	for _, test := range []struct {
+		variablesMap types.Dynamic
+		expected     path.Path
+	}{
+		{types.DynamicNull(), path.Root("variables")},
+		{types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")})), path.Root("variables_map")},
+	} {
+		var diags diag.Diagnostics
+		addAPIErrorDiagnostics(&diags, "Error making API http request", apiError, withVariablesMapFieldPath(hostAPIFieldPaths, "variables", "variables_map", test.variablesMap))
+
+		withPath, ok := diags[0].(diag.DiagnosticWithPath)
+		if len(diags) != 1 || !ok || !withPath.Path().Equal(test.expected) {
+			t.Errorf("expected the variables error to point at %s, got %v", test.expected, diags)
+		}
+	}
+
+	if !hostAPIFieldPaths["variables"].Equal(path.Root("variables")) {
+		t.Errorf("expected the shared field paths to be left unchanged, got %s", hostAPIFieldPaths["variables"])
+	}
+}
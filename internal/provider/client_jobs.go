@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// How long to wait between polls of a running job. A variable so tests can shorten it.
+var jobPollInterval = 5 * time.Second
+
+// Statuses of a unified job (a job, workflow job, project update or inventory update) that won't change anymore.
+var jobFinishedStatuses = []string{"successful", "failed", "error", "canceled"}
+
+// The fields shared by every kind of unified job.
+type unifiedJobAPIModel struct {
+	Id             int            `json:"id"`
+	Name           string         `json:"name"`
+	Status         string         `json:"status"`
+	Failed         bool           `json:"failed"`
+	Elapsed        float64        `json:"elapsed"`
+	JobExplanation string         `json:"job_explanation"`
+	Artifacts      map[string]any `json:"artifacts"`
+}
+
+func (j unifiedJobAPIModel) finished() bool {
+	return slices.Contains(jobFinishedStatuses, j.Status)
+}
+
+// Describes why a finished job didn't succeed, for diagnostics.
+func (j unifiedJobAPIModel) failureDescription() string {
+	description := fmt.Sprintf("Job %d (%s) finished with status %q.", j.Id, j.Name, j.Status)
+	if j.JobExplanation != "" {
+		description += " " + j.JobExplanation
+	}
+
+	return description
+}
+
+// Reads a unified job, i.e. url "jobs/42/".
+func (c *providerClient) getUnifiedJob(ctx context.Context, url string) (unifiedJobAPIModel, int, error) {
+	var job unifiedJobAPIModel
+
+	body, statusCode, err := c.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	if err != nil || statusCode == 404 {
+		return job, statusCode, err
+	}
+
+	if err := json.Unmarshal(body, &job); err != nil {
+		return job, statusCode, fmt.Errorf("unable to unmarshal %s: %w", url, err)
+	}
+
+	return job, statusCode, nil
+}
+
+// Polls a unified job until it finishes, and returns it in its final state. Waiting stops with an error when ctx
+// is done, which is how callers bound the wait. onPoll, when not nil, is called with the job each time it's found
+// still running, and stops the wait by returning an error.
+func (c *providerClient) waitForUnifiedJob(ctx context.Context, url string, onPoll func(unifiedJobAPIModel) error) (unifiedJobAPIModel, error) {
+	var last unifiedJobAPIModel
+	gaveUp := func() error {
+		return fmt.Errorf("gave up waiting for job %d, last seen with status %q: %w", last.Id, last.Status, ctx.Err())
+	}
+
+	for {
+		job, statusCode, err := c.getUnifiedJob(ctx, url)
+		if err != nil {
+			// the wait may run out in the middle of a request as well as between them
+			if ctx.Err() != nil {
+				return last, gaveUp()
+			}
+			return job, err
+		}
+		if statusCode == 404 {
+			return job, fmt.Errorf("%s no longer exists", url)
+		}
+		if job.finished() {
+			return job, nil
+		}
+
+		if onPoll != nil {
+			if err := onPoll(job); err != nil {
+				return job, err
+			}
+		}
+
+		last = job
+		SleepWithContext(ctx, jobPollInterval)
+		if ctx.Err() != nil {
+			return last, gaveUp()
+		}
+	}
+}
+
+// The terminal escape sequences Ansible colors its output with.
+var ansiEscapeSequence = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// Reads the output of a unified job, i.e. url "jobs/42/", from startLine on. Returns the lines, without terminal
+// escape sequences, and the line to continue from once the job has written more.
+func (c *providerClient) unifiedJobStdout(ctx context.Context, url string, startLine int) ([]string, int, error) {
+	stdoutURL := fmt.Sprintf("%sstdout/?format=json&start_line=%d", url, startLine)
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, stdoutURL, nil, []int{200}, "")
+	if err != nil {
+		return nil, startLine, err
+	}
+
+	var stdout struct {
+		Range struct {
+			End int `json:"end"`
+		} `json:"range"`
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal(body, &stdout); err != nil {
+		return nil, startLine, fmt.Errorf("unable to unmarshal the output of %s: %w", url, err)
+	}
+
+	content := strings.TrimRight(ansiEscapeSequence.ReplaceAllString(stdout.Content, ""), "\r\n")
+	if content == "" {
+		return nil, startLine, nil
+	}
+
+	return strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n"), max(stdout.Range.End, startLine), nil
+}
+
+// A node of a workflow job, with the job it ran, if any.
+type workflowJobNodeAPIModel struct {
+	Id            int  `json:"id"`
+	Job           *int `json:"job"`
+	DoNotRun      bool `json:"do_not_run"`
+	SummaryFields struct {
+		Job struct {
+			Id      int     `json:"id"`
+			Name    string  `json:"name"`
+			Type    string  `json:"type"`
+			Status  string  `json:"status"`
+			Elapsed float64 `json:"elapsed"`
+		} `json:"job"`
+		UnifiedJobTemplate struct {
+			Name string `json:"name"`
+		} `json:"unified_job_template"`
+	} `json:"summary_fields"`
+}
+
+func (n workflowJobNodeAPIModel) pendingApproval() bool {
+	return n.Job != nil && n.SummaryFields.Job.Type == "workflow_approval" && n.SummaryFields.Job.Status == "pending"
+}
+
+// Lists the nodes of a workflow job, ordered by id.
+func (c *providerClient) listWorkflowJobNodes(ctx context.Context, workflowJobID int) ([]workflowJobNodeAPIModel, error) {
+	url := fmt.Sprintf("workflow_jobs/%d/workflow_nodes/?order_by=id", workflowJobID)
+	body, _, err := c.ListAPIRequest(ctx, url, []int{200}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes struct {
+		Results []workflowJobNodeAPIModel `json:"results"`
+	}
+	if err := json.Unmarshal(body, &nodes); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the nodes of workflow job %d: %w", workflowJobID, err)
+	}
+
+	return nodes.Results, nil
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Throttles the API requests made by providerClient so that a large plan run with high -parallelism can't
+// overwhelm the controller. slots caps the number of requests in flight at once and interval spaces the start
+// of each request to honour a requests-per-second limit. Either can be disabled by leaving it at its zero value.
+type requestLimiter struct {
+	slots    chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// Returns nil when neither limit is set, which acquire() and release() treat as unlimited.
+func newRequestLimiter(maxConcurrentRequests int32, requestsPerSecond float64) *requestLimiter {
+	if maxConcurrentRequests <= 0 && requestsPerSecond <= 0 {
+		return nil
+	}
+
+	limiter := &requestLimiter{}
+
+	if maxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	if requestsPerSecond > 0 {
+		limiter.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+
+	return limiter
+}
+
+// Blocks until a request may be sent and returns how long that took. Every successful acquire() must be
+// followed by a release().
+func (l *requestLimiter) acquire(ctx context.Context) (time.Duration, error) {
+	if l == nil {
+		return 0, nil
+	}
+
+	start := time.Now()
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return time.Since(start), ctx.Err()
+		}
+	}
+
+	if l.interval > 0 {
+		l.mu.Lock()
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		wait := l.next.Sub(now)
+		l.next = l.next.Add(l.interval)
+		l.mu.Unlock()
+
+		if wait > 0 {
+			SleepWithContext(ctx, wait)
+			if ctx.Err() != nil {
+				l.release()
+				return time.Since(start), ctx.Err()
+			}
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+func (l *requestLimiter) release() {
+	if l == nil || l.slots == nil {
+		return
+	}
+
+	<-l.slots
+}
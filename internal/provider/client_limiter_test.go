@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiter_maxConcurrent(t *testing.T) {
+	limiter := newRequestLimiter(2, 0)
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := limiter.acquire(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			defer limiter.release()
+
+			current := inFlight.Add(1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	if maxInFlight.Load() > 2 {
+		t.Fatalf("expected at most 2 requests in flight, saw %d", maxInFlight.Load())
+	}
+}
+
+func TestRequestLimiter_requestsPerSecond(t *testing.T) {
+	limiter := newRequestLimiter(0, 100)
+
+	start := time.Now()
+	for range 5 {
+		if _, err := limiter.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		limiter.release()
+	}
+
+	// the first request starts immediately, the remaining four are spaced 10ms apart
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("expected requests to be spaced out over at least 40ms, took %s", elapsed)
+	}
+}
+
+func TestRequestLimiter_cancelledContext(t *testing.T) {
+	limiter := newRequestLimiter(1, 0)
+
+	if _, err := limiter.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.acquire(ctx); err == nil {
+		t.Fatal("expected an error when the context expires while waiting for a slot")
+	}
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
+)
+
+// All API calls are logged under this tflog subsystem. Its level can be raised or lowered independently of
+// the rest of the provider with TF_LOG_PROVIDER_<PREFIX>_API, i.e. TF_LOG_PROVIDER_AWX_API=trace.
+const apiLogSubsystem = "api"
+
+const redactedValue = "***"
+
+// Keys whose values are never written to the logs, wherever they appear in a request or response body.
+var sensitiveBodyKeys = []string{
+	"password",
+	"token",
+	"secret",
+	"ssh_key_data",
+	"ssh_key_unlock",
+	"ssh_public_key_data",
+	"authorize_password",
+	"become_password",
+	"vault_password",
+	"security_token",
+	"webhook_key",
+	"host_config_key",
+}
+
+// Objects under these keys hold credential material as arbitrary fields (i.e. a credential's inputs), so
+// every value inside them is redacted rather than just the ones with sensitive looking keys.
+var sensitiveBodyObjects = []string{
+	"inputs",
+	"notification_configuration",
+}
+
+func apiLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, apiLogSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER", strings.ToUpper(configprefix.Prefix), "API"),
+		tflog.WithRootFields(),
+	)
+}
+
+// Returns the headers of a request as a flat map with the Authorization header and any cookies masked.
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+
+	for name, values := range header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "Cookie", "Set-Cookie", "X-Csrftoken":
+			redacted[name] = redactedValue
+		default:
+			redacted[name] = strings.Join(values, ", ")
+		}
+	}
+
+	return redacted
+}
+
+// Returns a JSON body with sensitive values masked, for logging. Bodies that aren't JSON are dropped entirely,
+// as there is no way to tell what they contain.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	redacted, err := json.Marshal(redactValue(decoded, false))
+	if err != nil {
+		return "<unable to redact body>"
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value any, redactAll bool) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			switch {
+			case isSensitiveBodyKey(key):
+				typed[key] = redactLeaves(child)
+			case containsFold(sensitiveBodyObjects, key):
+				typed[key] = redactValue(child, true)
+			default:
+				typed[key] = redactValue(child, redactAll)
+			}
+		}
+		return typed
+	case []any:
+		for i, child := range typed {
+			typed[i] = redactValue(child, redactAll)
+		}
+		return typed
+	default:
+		if redactAll {
+			return redactLeaves(typed)
+		}
+		return typed
+	}
+}
+
+// Masks every non-empty scalar, keeping the shape of objects and lists so the log still shows which fields
+// were sent.
+func redactLeaves(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			typed[key] = redactLeaves(child)
+		}
+		return typed
+	case []any:
+		for i, child := range typed {
+			typed[i] = redactLeaves(child)
+		}
+		return typed
+	case nil:
+		return nil
+	case string:
+		if typed == "" {
+			return typed
+		}
+		return redactedValue
+	default:
+		return redactedValue
+	}
+}
+
+// Returns a new map holding the fields of both maps, so a shared set of fields can be extended per log line.
+func mergeFields(base, extra map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(extra))
+	maps.Copy(merged, base)
+	maps.Copy(merged, extra)
+
+	return merged
+}
+
+func isSensitiveBodyKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+
+	for _, sensitive := range sensitiveBodyKeys {
+		if strings.Contains(lowerKey, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
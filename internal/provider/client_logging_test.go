@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{
+		"name": "machine credential",
+		"credential_type": 1,
+		"inputs": {"username": "admin", "password": "hunter2", "become_method": ""},
+		"token": "abc123",
+		"results": [{"id": 1, "vault_password": "s3cret"}]
+	}`)
+
+	var redacted map[string]any
+	if err := json.Unmarshal([]byte(redactBody(body)), &redacted); err != nil {
+		t.Fatalf("redacted body is not valid JSON: %v", err)
+	}
+
+	if redacted["name"] != "machine credential" {
+		t.Errorf("expected non-sensitive name to be kept, got %v", redacted["name"])
+	}
+
+	inputs := redacted["inputs"].(map[string]any)
+	if inputs["username"] != redactedValue || inputs["password"] != redactedValue {
+		t.Errorf("expected every credential input to be masked, got %v", inputs)
+	}
+
+	if inputs["become_method"] != "" {
+		t.Errorf("expected empty input to stay empty, got %v", inputs["become_method"])
+	}
+
+	if redacted["token"] != redactedValue {
+		t.Errorf("expected token to be masked, got %v", redacted["token"])
+	}
+
+	result := redacted["results"].([]any)[0].(map[string]any)
+	if result["vault_password"] != redactedValue || result["id"] != float64(1) {
+		t.Errorf("expected nested password to be masked and id kept, got %v", result)
+	}
+
+	if got := redactBody([]byte("plain text with a password")); got != "<non-JSON body omitted>" {
+		t.Errorf("expected non-JSON body to be omitted, got %q", got)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer abc123")
+	header.Set("X-Tenant-Id", "platform-team")
+
+	redacted := redactHeaders(header)
+
+	if redacted["Authorization"] != redactedValue {
+		t.Errorf("expected Authorization header to be masked, got %q", redacted["Authorization"])
+	}
+
+	if redacted["X-Tenant-Id"] != "platform-team" {
+		t.Errorf("expected X-Tenant-Id header to be kept, got %q", redacted["X-Tenant-Id"])
+	}
+}
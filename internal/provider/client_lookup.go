@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	urlParser "net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// One segment of a natural import key such as `Default/My Template`. The segment's name is looked up in
+// collection, scoped by the ids resolved for the segments before it: filters holds the query parameter to
+// filter on for each earlier segment, or "" to not scope by that segment.
+type importKeySegment struct {
+	label      string
+	collection string
+	nameField  string
+	filters    []string
+	hint       string
+}
+
+// The natural key of an object that is unique by name within an organization, i.e. `Default/My Template`.
+func organizationScopedImportKey(collection, hint string) []importKeySegment {
+	return []importKeySegment{
+		{label: "organization", collection: "organizations", hint: hint},
+		{label: "name", collection: collection, filters: []string{"organization"}, hint: hint},
+	}
+}
+
+// The natural key of an object that is unique by name within an inventory, i.e. `Servers/web01`.
+func inventoryScopedImportKey(collection string) []importKeySegment {
+	return []importKeySegment{
+		{label: "inventory", collection: "inventories"},
+		{label: "name", collection: collection, filters: []string{"inventory"}},
+	}
+}
+
+// The natural key of an object that is unique by name on its own, i.e. `Default`.
+func nameImportKey(collection, hint string) []importKeySegment {
+	return []importKeySegment{{label: "name", collection: collection, hint: hint}}
+}
+
+// Organizations are imported by their controller id, which Read, Update and Delete address them by, even on
+// AAP 2.5+ where the gateway numbers the same organizations differently.
+var organizationImportKey = nameImportKey("organizations", "")
+
+// Credentials are unique by name within an organization and credential type, i.e. `Default/Machine/root`.
+var credentialImportKey = []importKeySegment{
+	{label: "organization", collection: "organizations"},
+	{label: "credential-type", collection: "credential_types"},
+	{label: "name", collection: "credentials", filters: []string{"organization", "credential_type"}},
+}
+
+// Looks up the id of the single object in collection whose nameField equals name, narrowed by the query
+// parameters in scope. Finding no object, or more than one, is an error.
+func (c *providerClient) lookupIDByName(ctx context.Context, collection, nameField, name string, scope urlParser.Values, hint string) (int, error) {
+	query := urlParser.Values{}
+	for key, values := range scope {
+		query[key] = values
+	}
+	query.Set(nameField, name)
+
+	url := fmt.Sprintf("%s/?%s", collection, query.Encode())
+	body, _, err := c.ListAPIRequest(ctx, url, []int{200}, hint)
+	if err != nil {
+		return 0, err
+	}
+
+	nameResult := struct {
+		Count   int `json:"count"`
+		Results []struct {
+			Id int `json:"id"`
+		} `json:"results"`
+	}{}
+	err = json.Unmarshal(body, &nameResult)
+	if err != nil {
+		return 0, fmt.Errorf("unable to unmarshal %s lookup response: %v", collection, err)
+	}
+
+	if nameResult.Count != 1 {
+		return 0, fmt.Errorf("expected exactly one of %s with %s %q, found %d", collection, nameField, name, nameResult.Count)
+	}
+
+	return nameResult.Results[0].Id, nil
+}
+
+// Turns an import id into the numeric id of the object. A numeric id is returned as is; anything else is read
+// as a `/`-separated natural key with one part per segment, each resolved by name. The last part takes the
+// rest of the id, so the object's own name may contain a `/`.
+func (c *providerClient) resolveImportID(ctx context.Context, importID string, segments []importKeySegment) (string, error) {
+	if _, err := strconv.Atoi(importID); err == nil {
+		return importID, nil
+	}
+
+	labels := make([]string, len(segments))
+	for i, segment := range segments {
+		labels[i] = segment.label
+	}
+
+	parts := strings.SplitN(importID, "/", len(segments))
+	if len(parts) != len(segments) || slices.Contains(parts, "") {
+		return "", fmt.Errorf("import id %q must be a numeric id or of the form %s", importID, strings.Join(labels, "/"))
+	}
+
+	ids := make([]int, 0, len(segments))
+	for i, segment := range segments {
+		scope := urlParser.Values{}
+		for j, filter := range segment.filters {
+			if filter != "" {
+				scope.Set(filter, strconv.Itoa(ids[j]))
+			}
+		}
+
+		nameField := segment.nameField
+		if nameField == "" {
+			nameField = "name"
+		}
+
+		id, err := c.lookupIDByName(ctx, segment.collection, nameField, parts[i], scope, segment.hint)
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve %s %q: %v", segment.label, parts[i], err)
+		}
+		ids = append(ids, id)
+	}
+
+	return strconv.Itoa(ids[len(ids)-1]), nil
+}
+
+// The ImportState body shared by resources that can be imported by natural key as well as by numeric id. The
+// resolved id is written to attributePath.
+func importStateByNaturalKey(ctx context.Context, client *providerClient, attributePath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse, segments []importKeySegment) {
+	id, err := client.resolveImportID(ctx, req.ID, segments)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to resolve import id",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attributePath, id)...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResolveImportID(t *testing.T) {
+	fake := newFakeController(t)
+	defaultID := fake.seed("organizations", map[string]any{"name": "Default"})
+	otherID := fake.seed("organizations", map[string]any{"name": "Other"})
+	fake.seed("job_templates", map[string]any{"name": "deploy", "organization": otherID})
+	deployID := fake.seed("job_templates", map[string]any{"name": "deploy", "organization": defaultID})
+	slashID := fake.seed("job_templates", map[string]any{"name": "web/deploy", "organization": defaultID})
+	machineID := fake.seed("credential_types", map[string]any{"name": "Machine"})
+	scmID := fake.seed("credential_types", map[string]any{"name": "Source Control"})
+	fake.seed("credentials", map[string]any{"name": "root", "organization": defaultID, "credential_type": scmID})
+	rootID := fake.seed("credentials", map[string]any{"name": "root", "organization": defaultID, "credential_type": machineID})
+
+	client := fake.client()
+
+	cases := []struct {
+		importID string
+		segments []importKeySegment
+		expected int64
+	}{
+		{"42", organizationScopedImportKey("job_templates", ""), 42},
+		{"Default/deploy", organizationScopedImportKey("job_templates", ""), deployID},
+		{"Default/web/deploy", organizationScopedImportKey("job_templates", ""), slashID},
+		{"Default/Machine/root", credentialImportKey, rootID},
+		{"Other", organizationImportKey, otherID},
+	}
+	for _, c := range cases {
+		id, err := client.resolveImportID(context.Background(), c.importID, c.segments)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.importID, err)
+			continue
+		}
+		if id != fmt.Sprint(c.expected) {
+			t.Errorf("%s: expected id %d, got %s", c.importID, c.expected, id)
+		}
+	}
+
+	errorCases := []struct {
+		importID string
+		segments []importKeySegment
+		message  string
+	}{
+		{"deploy", organizationScopedImportKey("job_templates", ""), "of the form organization/name"},
+		{"Default/", organizationScopedImportKey("job_templates", ""), "of the form organization/name"},
+		{"Missing/deploy", organizationScopedImportKey("job_templates", ""), `unable to resolve organization "Missing"`},
+		{"Default/missing", organizationScopedImportKey("job_templates", ""), "found 0"},
+		{"deploy", nameImportKey("job_templates", ""), "found 2"},
+	}
+	for _, c := range errorCases {
+		_, err := client.resolveImportID(context.Background(), c.importID, c.segments)
+		if err == nil || !strings.Contains(err.Error(), c.message) {
+			t.Errorf("%s: expected an error containing %q, got: %v", c.importID, c.message, err)
+		}
+	}
+}
+
+func TestResolveImportID_aapOrganization(t *testing.T) {
+	// the gateway and the controller number the same organization differently
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == gatewayAPIPrefix+"organizations/" && r.URL.Query().Get("name") == "Default":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 7}]}`)
+		case r.URL.Path == "/api/controller/v2/organizations/" && r.URL.Query().Get("name") == "Default":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 3}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:   server.Client(),
+		endpoint: server.URL,
+	}
+	client.setPlatform(platformAAP)
+
+	id, err := client.resolveImportID(context.Background(), "Default", organizationImportKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "3" {
+		t.Errorf("expected the controller's organization id 3, got %s", id)
+	}
+}
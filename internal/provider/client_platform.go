@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+)
+
+// The two API layouts this provider can talk to. platformAWX covers AWX and AAP 2.4 and earlier, where the
+// controller API lives at /api/v2/. platformAAP covers AAP 2.5 and later, where the controller API moves to
+// /api/controller/v2/ and organizations, teams and users are managed through the platform gateway.
+const (
+	platformAuto = "auto"
+	platformAWX  = "awx"
+	platformAAP  = "aap"
+)
+
+const gatewayAPIPrefix = "/api/gateway/v1/"
+
+// Sets the platform and the matching controller api prefix used by buildAPIUrl().
+func (c *providerClient) setPlatform(platform string) {
+	c.platform = platform
+
+	if platform == platformAAP {
+		c.urlPrefix = "/api/controller/v2/"
+	} else {
+		c.urlPrefix = "/api/v2/"
+	}
+}
+
+// Works out which platform the endpoint is by probing, in order:
+//   - /api/, which lists the gateway among its apis on AAP 2.5+ and a current_version on AWX,
+//   - /api/gateway/v1/, which only exists on AAP 2.5+ (and may require authentication),
+//   - /api/v2/ping/, which only answers on AWX and AAP 2.4.
+func (c *providerClient) detectPlatform(ctx context.Context) (string, error) {
+	body, statusCode, _, err := c.doAPIRequest(ctx, http.MethodGet, c.endpoint+"/api/", nil, []int{200, 401, 403, 404})
+	if err == nil && statusCode == http.StatusOK {
+		apiRoot := struct {
+			CurrentVersion string         `json:"current_version"`
+			Apis           map[string]any `json:"apis"`
+		}{}
+
+		if json.Unmarshal(body, &apiRoot) == nil {
+			if _, ok := apiRoot.Apis["gateway"]; ok {
+				return platformAAP, nil
+			}
+			if apiRoot.CurrentVersion != "" {
+				return platformAWX, nil
+			}
+		}
+	}
+
+	_, statusCode, _, err = c.doAPIRequest(ctx, http.MethodGet, c.endpoint+gatewayAPIPrefix, nil, []int{200, 401, 403, 404})
+	if err == nil && slices.Contains([]int{200, 401, 403}, statusCode) {
+		return platformAAP, nil
+	}
+
+	_, statusCode, _, err = c.doAPIRequest(ctx, http.MethodGet, c.endpoint+"/api/v2/ping/", nil, []int{200, 401, 403, 404})
+	if err == nil && statusCode == http.StatusOK {
+		return platformAWX, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return "", errors.New("none of /api/, /api/gateway/v1/ or /api/v2/ping/ identified the endpoint as AWX or AAP")
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDetectPlatform(t *testing.T) {
+	testCases := map[string]struct {
+		handler  http.HandlerFunc
+		expected string
+	}{
+		"awx api root": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/" {
+					fmt.Fprint(w, `{"description": "AWX REST API", "current_version": "/api/v2/"}`)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+			expected: platformAWX,
+		},
+		"aap api root": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/" {
+					fmt.Fprint(w, `{"apis": {"gateway": "/api/gateway/", "controller": "/api/controller/"}}`)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+			expected: platformAAP,
+		},
+		"gateway requiring authentication": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == gatewayAPIPrefix {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+			expected: platformAAP,
+		},
+		"awx ping only": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v2/ping/" {
+					fmt.Fprint(w, `{"version": "24.6.1"}`)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+			expected: platformAWX,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+
+			client := &providerClient{
+				client:   server.Client(),
+				endpoint: server.URL,
+			}
+
+			platform, err := client.detectPlatform(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if platform != testCase.expected {
+				t.Fatalf("expected platform %q, got %q", testCase.expected, platform)
+			}
+		})
+	}
+}
+
+func TestDetectPlatform_unknown(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client := &providerClient{
+		client:   server.Client(),
+		endpoint: server.URL,
+	}
+
+	if _, err := client.detectPlatform(context.Background()); err == nil {
+		t.Fatal("expected an error when no probe identifies the platform")
+	}
+}
+
+func TestSetPlatform(t *testing.T) {
+	client := &providerClient{endpoint: "https://aap.example.com"}
+
+	client.setPlatform(platformAAP)
+	if got := client.buildAPIUrl("organizations/", "gateway"); got != "https://aap.example.com/api/gateway/v1/organizations/" {
+		t.Errorf("unexpected gateway url: %s", got)
+	}
+	if got := client.buildAPIUrl("job_templates/", ""); got != "https://aap.example.com/api/controller/v2/job_templates/" {
+		t.Errorf("unexpected controller url: %s", got)
+	}
+
+	client.setPlatform(platformAWX)
+	if got := client.buildAPIUrl("organizations/", "gateway"); got != "https://aap.example.com/api/v2/organizations/" {
+		t.Errorf("expected the gateway hint to be ignored on awx, got: %s", got)
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// How many of the last lines of a failed project sync's output to report.
+const projectSyncOutputLines = 30
+
+// The fields of a project that point at its source control syncs.
+type projectSyncAPIModel struct {
+	ScmType       string `json:"scm_type"`
+	SummaryFields struct {
+		CurrentUpdate *struct {
+			Id int `json:"id"`
+		} `json:"current_update"`
+		LastUpdate *struct {
+			Id int `json:"id"`
+		} `json:"last_update"`
+	} `json:"summary_fields"`
+}
+
+// Waits for the project's running source control sync. When none is running and includeFinished is set, returns its
+// last one instead: the controller starts a sync when a project is created or its source changes, which may be over
+// by the time it's looked for. Returns nil when there's no such sync, i.e. for manual projects.
+func (c *providerClient) waitForProjectSync(ctx context.Context, projectID int, includeFinished bool) (*unifiedJobAPIModel, error) {
+	url := fmt.Sprintf("projects/%d/", projectID)
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var project projectSyncAPIModel
+	if err := json.Unmarshal(body, &project); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal %s: %w", url, err)
+	}
+
+	update := project.SummaryFields.CurrentUpdate
+	if update == nil && includeFinished {
+		update = project.SummaryFields.LastUpdate
+	}
+	if project.ScmType == "" || update == nil {
+		return nil, nil
+	}
+
+	job, err := c.waitForUnifiedJob(ctx, fmt.Sprintf("project_updates/%d/", update.Id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Describes a project sync that didn't succeed, ending with the last lines of its output, which say why.
+func (c *providerClient) projectSyncFailureDescription(ctx context.Context, update unifiedJobAPIModel) string {
+	description := update.failureDescription()
+
+	lines, _, err := c.unifiedJobStdout(ctx, fmt.Sprintf("project_updates/%d/", update.Id), 0)
+	if err != nil {
+		return description + fmt.Sprintf(" Its output could not be read: %s.", err.Error())
+	}
+	if len(lines) > projectSyncOutputLines {
+		lines = lines[len(lines)-projectSyncOutputLines:]
+	}
+	for _, line := range lines {
+		description += "\n" + line
+	}
+
+	return description
+}
+
+// Lists the playbooks found in the project's last successful sync.
+func (c *providerClient) projectPlaybooks(ctx context.Context, projectID int) ([]string, error) {
+	url := fmt.Sprintf("projects/%d/playbooks/", projectID)
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var playbooks []string
+	if err := json.Unmarshal(body, &playbooks); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal %s: %w", url, err)
+	}
+
+	return playbooks, nil
+}
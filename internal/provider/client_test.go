@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListAPIRequest_followsNext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_size") != fmt.Sprint(listPageSize) {
+			t.Errorf("expected page_size=%d, got query %q", listPageSize, r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"count": 3, "next": "/api/v2/job_templates/1/labels/?page=2&page_size=%d", "results": [{"id": 1}, {"id": 2}]}`, listPageSize)
+		case "2":
+			fmt.Fprint(w, `{"count": 3, "next": null, "results": [{"id": 3}]}`)
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.RawQuery)
+		}
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:    server.Client(),
+		endpoint:  server.URL,
+		urlPrefix: "/api/v2/",
+	}
+
+	body, statusCode, err := client.ListAPIRequest(context.Background(), "job_templates/1/labels/", []int{200}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if statusCode != 200 {
+		t.Fatalf("expected status 200, got %d", statusCode)
+	}
+
+	var result JTChildAPIRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("unable to unmarshal combined body: %v", err)
+	}
+
+	if result.Count != 3 || len(result.Results) != 3 {
+		t.Fatalf("expected 3 results across both pages, got count %d with %d results", result.Count, len(result.Results))
+	}
+
+	for i, v := range result.Results {
+		if v.Id != i+1 {
+			t.Errorf("expected result %d to have id %d, got %d", i, i+1, v.Id)
+		}
+	}
+}
+
+func TestListAPIRequestLimit_stopsPaging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_size") != "3" {
+			t.Errorf("expected the page size to be cut to the limit, got query %q", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// the controller caps pages at 2 here, so the limit is only reached on the second page
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprint(w, `{"count": 6, "next": "/api/v2/hosts/?page=2&page_size=3", "results": [{"id": 1}, {"id": 2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"count": 6, "next": "/api/v2/hosts/?page=3&page_size=3", "results": [{"id": 3}, {"id": 4}]}`)
+		default:
+			t.Errorf("expected no page after the limit was reached, got %s", r.URL.RawQuery)
+		}
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:    server.Client(),
+		endpoint:  server.URL,
+		urlPrefix: "/api/v2/",
+	}
+
+	body, _, err := client.ListAPIRequestLimit(context.Background(), "hosts/", 3, []int{200}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result JTChildAPIRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("unable to unmarshal combined body: %v", err)
+	}
+
+	if result.Count != 3 || len(result.Results) != 3 || result.Results[2].Id != 3 {
+		t.Fatalf("expected the first 3 results, got %+v", result)
+	}
+}
+
+func TestListAPIRequest_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "Not found."}`)
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:    server.Client(),
+		endpoint:  server.URL,
+		urlPrefix: "/api/v2/",
+	}
+
+	_, statusCode, err := client.ListAPIRequest(context.Background(), "job_templates/1/labels/", []int{200, 404}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if statusCode != 404 {
+		t.Fatalf("expected status 404 to be passed through, got %d", statusCode)
+	}
+}
+
+func TestGenericAPIRequest_retriesMutatingRequestWithBody(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		var body LabelResult
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Id != 7 {
+			t.Errorf("attempt %d: expected request body with id 7, got %+v (err %v)", attempts, body, err)
+		}
+
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:                   server.Client(),
+		endpoint:                 server.URL,
+		urlPrefix:                "/api/v2/",
+		apiRetryCount:            2,
+		apiRetryMutatingRequests: true,
+	}
+
+	_, statusCode, err := client.GenericAPIRequest(context.Background(), http.MethodPost, "job_templates/1/labels/", LabelResult{Id: 7}, []int{204}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if statusCode != http.StatusNoContent || attempts != 2 {
+		t.Fatalf("expected success on the second attempt, got status %d after %d attempts", statusCode, attempts)
+	}
+}
+
+func TestGenericAPIRequest_doesNotRetryMutatingRequestByDefault(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:        server.Client(),
+		endpoint:      server.URL,
+		urlPrefix:     "/api/v2/",
+		apiRetryCount: 3,
+	}
+
+	_, _, err := client.GenericAPIRequest(context.Background(), http.MethodPost, "labels/", LabelResult{Id: 7}, []int{201}, "")
+	if err == nil {
+		t.Fatal("expected an error for a 502 response")
+	}
+
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	client := &providerClient{
+		apiRetryDelaySeconds:       2,
+		apiRetryExponentialBackoff: true,
+		apiRetryMaxDelaySeconds:    5,
+	}
+
+	expected := []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for attempt, want := range expected {
+		if got := client.retryDelay(attempt, nil); got != want {
+			t.Errorf("attempt %d: expected delay %s, got %s", attempt, want, got)
+		}
+	}
+
+	tooManyRequests := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"10"}},
+	}
+
+	if got := client.retryDelay(0, tooManyRequests); got != 10*time.Second {
+		t.Errorf("expected Retry-After of 10s to be honoured, got %s", got)
+	}
+
+	client.apiRetryJitter = true
+	for attempt := range 3 {
+		got := client.retryDelay(attempt, nil)
+		if got < time.Second || got > 5*time.Second {
+			t.Errorf("attempt %d: jittered delay %s outside of expected bounds", attempt, got)
+		}
+	}
+}
+
+func TestRetryDelay_maxDelayOnlyBoundsBackoff(t *testing.T) {
+	client := &providerClient{
+		apiRetryDelaySeconds:    10,
+		apiRetryMaxDelaySeconds: 5,
+	}
+
+	for attempt := range 3 {
+		if got := client.retryDelay(attempt, nil); got != 10*time.Second {
+			t.Errorf("attempt %d: expected the fixed delay of 10s, got %s", attempt, got)
+		}
+	}
+}
+
+func TestGenericAPIRequest_extraHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Tenant-Id"); got != "platform-team" {
+			t.Errorf("expected X-Tenant-Id header to be platform-team, got %q", got)
+		}
+
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("expected the provider's Authorization header to win, got %q", got)
+		}
+
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	client := &providerClient{
+		client:    server.Client(),
+		endpoint:  server.URL,
+		urlPrefix: "/api/v2/",
+		auth:      "Bearer token",
+		headers: map[string]string{
+			"X-Tenant-Id":   "platform-team",
+			"Authorization": "Basic overridden",
+		},
+	}
+
+	_, _, err := client.GenericAPIRequest(context.Background(), http.MethodGet, "me/", nil, []int{200}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
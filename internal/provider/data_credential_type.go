@@ -125,7 +125,15 @@ func (d *CredentialTypeDataSource) Read(ctx context.Context, req datasource.Read
 		kind := urlParser.QueryEscape(data.Kind.ValueString())
 		url = fmt.Sprintf("credential_types/?name=%s&kind=%s", name, kind)
 	}
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Name.IsNull() && !data.Kind.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
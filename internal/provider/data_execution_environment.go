@@ -120,7 +120,15 @@ func (d *ExecutionEnvironmentDataSource) Read(ctx context.Context, req datasourc
 		url = fmt.Sprintf("execution_environments/?name=%s", name)
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Name.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
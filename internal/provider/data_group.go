@@ -50,9 +50,14 @@ func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Optional:    true,
 			},
 			"variables": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Group variables in JSON or YAML format.",
 				Computed:    true,
 			},
+			"variables_map": schema.DynamicAttribute{
+				Description: "Variables as an object rather than a JSON or YAML string.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -112,7 +117,15 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		url = fmt.Sprintf("groups/?name=%s&inventory=%d", name, data.Inventory.ValueInt32())
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Name.IsNull() && !data.Inventory.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -166,8 +179,15 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Description = types.StringValue(responseData.Description)
 	}
 	if responseData.Variables != "" {
-		data.Variables = types.StringValue(responseData.Variables)
+		data.Variables = NewVariablesStringValue(responseData.Variables)
+	}
+
+	variablesMap, diags := variablesStringToDynamic(responseData.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.VariablesMap = variablesMap
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
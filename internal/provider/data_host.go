@@ -54,9 +54,14 @@ func (d *HostDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Optional:    true,
 			},
 			"variables": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Specify `vars` for the template. Default value is `\"---\"`",
 				Computed:    true,
 			},
+			"variables_map": schema.DynamicAttribute{
+				Description: "Variables as an object rather than a JSON or YAML string.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -120,7 +125,15 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		url = fmt.Sprintf("hosts/?name=%s&inventory=%d", name, data.Inventory.ValueInt32())
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Name.IsNull() && !data.Inventory.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -179,8 +192,15 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	if responseData.Variables != "" {
-		data.Variables = types.StringValue(responseData.Variables)
+		data.Variables = NewVariablesStringValue(responseData.Variables)
+	}
+
+	variablesMap, diags := variablesStringToDynamic(responseData.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.VariablesMap = variablesMap
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -59,6 +59,7 @@ func (d *InstanceGroupDataSource) Schema(ctx context.Context, req datasource.Sch
 				Computed:    true,
 			},
 			"pod_spec_override": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "A custom Kubernetes or OpenShift Pod specification.",
 				Computed:    true,
 			},
@@ -129,7 +130,15 @@ func (d *InstanceGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 		url = fmt.Sprintf("instance_groups/?name=%s", name)
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Name.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -192,7 +201,7 @@ func (d *InstanceGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	if podSpecStr, ok := responseData.PodSpecOverride.(string); ok {
 		if podSpecStr != "" {
-			data.PodSpecOverride = types.StringValue(podSpecStr)
+			data.PodSpecOverride = NewVariablesStringValue(podSpecStr)
 		}
 	} else {
 		resp.Diagnostics.AddError(
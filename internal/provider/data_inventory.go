@@ -47,9 +47,14 @@ func (d *InventoryDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:    true,
 			},
 			"variables": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Enter inventory variables using either JSON or YAML syntax.",
 				Computed:    true,
 			},
+			"variables_map": schema.DynamicAttribute{
+				Description: "Variables as an object rather than a JSON or YAML string.",
+				Computed:    true,
+			},
 			"kind": schema.StringAttribute{
 				Description: "Set to `smart` for smart inventories",
 				Computed:    true,
@@ -134,8 +139,15 @@ func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	if responseData.Variables != "" {
-		data.Variables = types.StringValue(responseData.Variables)
+		data.Variables = NewVariablesStringValue(responseData.Variables)
+	}
+
+	variablesMap, diags := variablesStringToDynamic(responseData.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.VariablesMap = variablesMap
 
 	if responseData.Kind != "" {
 		data.Kind = types.StringValue(responseData.Kind)
@@ -88,6 +88,7 @@ func (d *InventorySourceDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 			},
 			"source_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Default value is `\"---\"`",
 				Computed:    true,
 			},
@@ -216,7 +217,7 @@ func (d *InventorySourceDataSource) Read(ctx context.Context, req datasource.Rea
 		data.Overwrite = types.BoolValue(responseData.Overwrite)
 	}
 	if responseData.SourceVars != "" {
-		data.SourceVars = types.StringValue(responseData.SourceVars)
+		data.SourceVars = NewVariablesStringValue(responseData.SourceVars)
 	}
 	if responseData.SourceProject != 0 {
 		data.SourceProject = types.Int32Value(int32(responseData.SourceProject))
@@ -78,9 +78,14 @@ func (d *JobTemplateDataSource) Schema(ctx context.Context, req datasource.Schem
 				Description: "Control the level of output ansible will produce as the playbook executes. `0 - Normal`, `1 - Verbose`, `2 - More Verbose`, `3 - Debug`, `4 - r.client.auth Debug`, `5 - WinRM Debug`",
 			},
 			"extra_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Computed:    true,
 				Description: "Specify `extra_vars` for the template.",
 			},
+			"extra_vars_map": schema.DynamicAttribute{
+				Description: "Extra variables as an object rather than a JSON or YAML string.",
+				Computed:    true,
+			},
 			"job_tags": schema.StringAttribute{
 				Computed:    true,
 				Description: "Tags are useful when you have a large playbook, and you want to run a specific part of a play or task. Use commas to separate multiple tags.",
@@ -272,7 +277,15 @@ func (d *JobTemplateDataSource) Read(ctx context.Context, req datasource.ReadReq
 		url = fmt.Sprintf("job_templates/?name=%s", name)
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Name.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -352,8 +365,15 @@ func (d *JobTemplateDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Verbosity = types.Int32Value(int32(responseData.Verbosity))
 	}
 	if responseData.ExtraVars != "" {
-		data.ExtraVars = types.StringValue(responseData.ExtraVars)
+		data.ExtraVars = NewVariablesStringValue(responseData.ExtraVars)
+	}
+
+	extraVarsMap, diags := variablesStringToDynamic(responseData.ExtraVars)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.ExtraVarsMap = extraVarsMap
 	if responseData.JobTags != "" {
 		data.JobTags = types.StringValue(responseData.JobTags)
 	}
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	urlParser "net/url"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -105,6 +105,15 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Description: "The amount of time (in seconds) to run before the SCM Update is canceled. A value of 0 means no timeout.",
 				Computed:    true,
 			},
+			"scm_revision": schema.StringAttribute{
+				Description: "The source control revision the project last synced.",
+				Computed:    true,
+			},
+			"playbooks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "The playbooks found in the project by its last successful sync.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -165,7 +174,7 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		url = fmt.Sprintf("projects/?name=%s", urlParser.QueryEscape(data.Name.ValueString()))
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -258,5 +267,19 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.ScmUrl = types.StringValue(responseData.ScmUrl)
 	}
 
+	data.ScmRevision = types.StringValue(responseData.ScmRevision)
+
+	playbooks, err := d.client.projectPlaybooks(ctx, responseData.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading playbooks",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Playbooks, diags = types.ListValueFrom(ctx, types.StringType, playbooks)
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
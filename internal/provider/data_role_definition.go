@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
@@ -90,6 +89,20 @@ func (d *RoleDefinitionDataSource) Configure(ctx context.Context, req datasource
 func (d *RoleDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data RoleDefinitionModel
 
+	// The capability check needs the platform, which is only known once the provider has been configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Data Source Client",
+			"The provider hasn't been configured, so the role definition can't be read. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client.requireCapability(&resp.Diagnostics, capabilityRoleAssignments, path.Empty())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -110,7 +123,7 @@ func (d *RoleDefinitionDataSource) Read(ctx context.Context, req datasource.Read
 		url = fmt.Sprintf("role_definitions/?name=%s", data.Name.ValueString())
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := d.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -91,3 +93,12 @@ data "%[1]s_role_definition" "test-name" {
 }
 `, configprefix.Prefix, resource.Name, resource.Description, resource.ContentType, resource.Permissions[0], resource.Permissions[1])
 }
+
+func TestRoleDefinitionDataSource_unconfigured(t *testing.T) {
+	var resp datasource.ReadResponse
+	(&RoleDefinitionDataSource{}).Read(context.Background(), datasource.ReadRequest{}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected reading without a configured client to report an error")
+	}
+}
@@ -124,7 +124,15 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		url = fmt.Sprintf("users/?username=%s", name)
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "gateway")
+	var body []byte
+	var statusCode int
+	var err error
+
+	if !data.Username.IsNull() {
+		body, statusCode, err = d.client.ListAPIRequest(ctx, url, []int{200, 404}, "gateway")
+	} else {
+		body, statusCode, err = d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "gateway")
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -0,0 +1,832 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	urlParser "net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExportOptions selects what Export writes and where.
+type ExportOptions struct {
+	// Name of the organization whose objects are exported.
+	Organization string
+	// Directory the .tf files are written to. It's created when missing; files in it with the names Export
+	// writes are overwritten.
+	Directory string
+}
+
+// One resource type written by the exporter. Its objects are listed from collection, filtered by the
+// organization's id, and each one is read into state by the managed resource, the same way a refresh after
+// `terraform import` does.
+type exportKind struct {
+	fileName    string
+	collection  string
+	filter      string
+	newResource func() resource.Resource
+	// The resource type, without the provider prefix, of the exported objects whose sub-collection lists this
+	// kind's objects. collection is then a format for the sub-collection's url, i.e. "inventories/%d/hosts", and
+	// filter isn't used.
+	parent string
+	// The state attribute the parent's id is set on before the Read, for resources managing one parent and child
+	// pair, whose import id is `<parent id>/<id>`.
+	parentAttribute string
+	// The state attribute the listed object's id is set on before the Read.
+	idAttribute string
+	// Other values set in state before the Read.
+	initialState map[string]attr.Value
+	// A set attribute that, when empty after the Read, means there is nothing to manage for the object.
+	skipWhenEmpty string
+	// Attributes holding the id of an object of another kind, by that kind's resource type without the provider
+	// prefix. They're written as a reference to the object's resource when that object is exported too.
+	references map[string]string
+}
+
+// The kinds Export writes, in order. A kind may only reference, or be listed from, kinds before it.
+var exportKinds = []exportKind{
+	{
+		fileName:    "organization.tf",
+		collection:  "organizations",
+		filter:      "id",
+		newResource: NewOrganizationResource,
+	},
+	{
+		fileName:    "credentials.tf",
+		collection:  "credentials",
+		filter:      "organization",
+		newResource: NewCredentialResource,
+		// the Read only fills in inputs from the controller when state holds an empty value of a known type
+		initialState: map[string]attr.Value{"inputs": types.DynamicValue(types.ObjectNull(map[string]attr.Type{}))},
+		references:   map[string]string{"organization": "organization"},
+	},
+	{
+		fileName:    "execution_environments.tf",
+		collection:  "execution_environments",
+		filter:      "organization",
+		newResource: NewExecutionEnvironmentResource,
+		references:  map[string]string{"organization": "organization", "credential": "credential"},
+	},
+	{
+		fileName:    "labels.tf",
+		collection:  "labels",
+		filter:      "organization",
+		newResource: NewLabelsResource,
+		references:  map[string]string{"organization": "organization"},
+	},
+	{
+		// secrets in notification_configuration come back as $encrypted$, which the controller keeps the stored
+		// value for when it's sent back
+		fileName:    "notification_templates.tf",
+		collection:  "notification_templates",
+		filter:      "organization",
+		newResource: NewNotificationTemplatesResource,
+		references:  map[string]string{"organization": "organization"},
+	},
+	{
+		fileName:    "projects.tf",
+		collection:  "projects",
+		filter:      "organization",
+		newResource: NewProjectResource,
+		references:  map[string]string{"organization": "organization", "credential": "credential"},
+	},
+	{
+		fileName:    "inventories.tf",
+		collection:  "inventories",
+		filter:      "organization",
+		newResource: NewInventoryResource,
+		references:  map[string]string{"organization": "organization"},
+	},
+	{
+		fileName:    "inventories.tf",
+		collection:  "inventories/%d/hosts",
+		parent:      "inventory",
+		newResource: NewHostResource,
+		references:  map[string]string{"inventory": "inventory"},
+	},
+	{
+		fileName:    "inventories.tf",
+		collection:  "inventories/%d/groups",
+		parent:      "inventory",
+		newResource: NewGroupResource,
+		references:  map[string]string{"inventory": "inventory"},
+	},
+	{
+		fileName:        "inventories.tf",
+		collection:      "groups/%d/hosts",
+		parent:          "group",
+		newResource:     NewGroupHostResource,
+		parentAttribute: "group_id",
+		idAttribute:     "host_id",
+		references:      map[string]string{"group_id": "group", "host_id": "host"},
+	},
+	{
+		fileName:    "inventories.tf",
+		collection:  "inventories/%d/inventory_sources",
+		parent:      "inventory",
+		newResource: NewInventorySourceResource,
+		references: map[string]string{
+			"inventory":             "inventory",
+			"credential":            "credential",
+			"execution_environment": "execution_environment",
+			"source_project":        "project",
+		},
+	},
+	{
+		fileName:    "job_templates.tf",
+		collection:  "job_templates",
+		filter:      "organization",
+		newResource: NewJobTemplateResource,
+		references: map[string]string{
+			"project":               "project",
+			"inventory":             "inventory",
+			"execution_environment": "execution_environment",
+			"webhook_credential":    "credential",
+		},
+	},
+	{
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateCredentialResource,
+		idAttribute:   "job_template_id",
+		skipWhenEmpty: "credential_ids",
+		references:    map[string]string{"job_template_id": "job_template", "credential_ids": "credential"},
+	},
+	{
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateLabelsResource,
+		idAttribute:   "job_template_id",
+		skipWhenEmpty: "label_ids",
+		references:    map[string]string{"job_template_id": "job_template", "label_ids": "label"},
+	},
+	{
+		// instance groups aren't part of an organization, so they're written as ids
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateInstanceGroupsResource,
+		idAttribute:   "job_template_id",
+		skipWhenEmpty: "instance_groups_ids",
+		references:    map[string]string{"job_template_id": "job_template"},
+	},
+	{
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateNotifTemplErrResource,
+		idAttribute:   "job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"job_template_id": "job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateNotifTemplStartedResource,
+		idAttribute:   "job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"job_template_id": "job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateNotifTemplSuccessResource,
+		idAttribute:   "job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"job_template_id": "job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "job_templates.tf",
+		collection:    "job_templates",
+		filter:        "organization",
+		newResource:   NewJobTemplateSurveyResource,
+		skipWhenEmpty: "spec",
+		references:    map[string]string{"id": "job_template"},
+	},
+	{
+		fileName:    "workflow_job_templates.tf",
+		collection:  "workflow_job_templates",
+		filter:      "organization",
+		newResource: NewWorkflowJobTemplateResource,
+		references:  map[string]string{"organization": "organization", "inventory": "inventory", "webhook_credential": "credential"},
+	},
+	{
+		fileName:      "workflow_job_templates.tf",
+		collection:    "workflow_job_templates",
+		filter:        "organization",
+		newResource:   NewWorkflowJobTemplateNotifTemplApprovalsResource,
+		idAttribute:   "workflow_job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"workflow_job_template_id": "workflow_job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "workflow_job_templates.tf",
+		collection:    "workflow_job_templates",
+		filter:        "organization",
+		newResource:   NewWorkflowJobTemplateNotifTemplErrorResource,
+		idAttribute:   "workflow_job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"workflow_job_template_id": "workflow_job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "workflow_job_templates.tf",
+		collection:    "workflow_job_templates",
+		filter:        "organization",
+		newResource:   NewWorkflowJobTemplateNotifTemplStartedResource,
+		idAttribute:   "workflow_job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"workflow_job_template_id": "workflow_job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "workflow_job_templates.tf",
+		collection:    "workflow_job_templates",
+		filter:        "organization",
+		newResource:   NewWorkflowJobTemplateNotifTemplSuccessResource,
+		idAttribute:   "workflow_job_template_id",
+		skipWhenEmpty: "notif_template_ids",
+		references:    map[string]string{"workflow_job_template_id": "workflow_job_template", "notif_template_ids": "notification_template"},
+	},
+	{
+		fileName:      "workflow_job_templates.tf",
+		collection:    "workflow_job_templates",
+		filter:        "organization",
+		newResource:   NewWorkflowJobTemplateSurveyResource,
+		skipWhenEmpty: "spec",
+		references:    map[string]string{"id": "workflow_job_template"},
+	},
+	{
+		fileName:    "schedules.tf",
+		collection:  "projects/%d/schedules",
+		parent:      "project",
+		newResource: NewScheduleResource,
+		references:  map[string]string{"unified_job_template": "project"},
+	},
+	{
+		fileName:    "schedules.tf",
+		collection:  "inventory_sources/%d/schedules",
+		parent:      "inventory_source",
+		newResource: NewScheduleResource,
+		references:  map[string]string{"unified_job_template": "inventory_source"},
+	},
+	{
+		fileName:    "schedules.tf",
+		collection:  "job_templates/%d/schedules",
+		parent:      "job_template",
+		newResource: NewScheduleResource,
+		references:  map[string]string{"unified_job_template": "job_template"},
+	},
+	{
+		fileName:    "schedules.tf",
+		collection:  "workflow_job_templates/%d/schedules",
+		parent:      "workflow_job_template",
+		newResource: NewScheduleResource,
+		references:  map[string]string{"unified_job_template": "workflow_job_template"},
+	},
+}
+
+// Resource types, without the provider prefix, for objects of an organization that Export doesn't write yet.
+// Teams and role assignments are managed through the gateway on AAP 2.5+, and the workflow nodes have no name to
+// give their resources.
+var exportUnsupportedKinds = []string{
+	"credential_input_sources",
+	"role_team_assignment",
+	"role_user_assignment",
+	"team",
+	"workflow_job_template_approval_node",
+	"workflow_job_template_job_node",
+	"workflow_job_template_job_node_credential",
+	"workflow_job_template_node_always",
+	"workflow_job_template_node_failure",
+	"workflow_job_template_node_label",
+	"workflow_job_template_node_success",
+}
+
+// What the controller returns in place of a secret.
+const encryptedValue = "$encrypted$"
+
+// Characters that can't be part of a resource or variable name.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Writes the objects of one organization as .tf files of resource blocks plus the `import {}` blocks that bring
+// them under management with Terraform 1.5 or later. The provider is configured the way it would be without a
+// provider block, from the TOWER_* and CONTROLLER_* environment variables and the config file. Returns the
+// addresses of the exported resources.
+func Export(ctx context.Context, version string, options ExportOptions) ([]string, error) {
+	client, err := newExportClient(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.export(ctx, options)
+}
+
+// Returns the resource types whose objects Export doesn't write, so callers can tell what's missing from an
+// export.
+func ExportUnsupportedTypes() []string {
+	typeNames := make([]string, 0, len(exportUnsupportedKinds))
+	for _, kind := range exportUnsupportedKinds {
+		typeNames = append(typeNames, configprefix.Prefix+"_"+kind)
+	}
+
+	return typeNames
+}
+
+// Builds a client by running the provider's Configure with an empty provider block.
+func newExportClient(ctx context.Context, version string) (*providerClient, error) {
+	p := New(version)()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	if err := diagsError(schemaResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	configureResp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)},
+	}, configureResp)
+	if err := diagsError(configureResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	client, ok := configureResp.ResourceData.(*providerClient)
+	if !ok {
+		return nil, fmt.Errorf("expected *providerClient, got: %T", configureResp.ResourceData)
+	}
+
+	return client, nil
+}
+
+// Turns the errors among diags into a single error, or nil when there are none.
+func diagsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// The state of one export run.
+type exporter struct {
+	client *providerClient
+
+	files     map[string]*hclwrite.File
+	fileNames []string
+	imports   *hclwrite.File
+	variables *hclwrite.File
+
+	// resource names by resource type, then by controller id
+	names map[string]map[int64]string
+	// resource names in use, by resource type
+	used map[string]map[string]bool
+	// exported resource addresses, in order
+	addresses []string
+	// whether any secret was written as a variable
+	hasVariables bool
+}
+
+func (c *providerClient) export(ctx context.Context, options ExportOptions) ([]string, error) {
+	organizationID, err := c.lookupIDByName(ctx, "organizations", "name", options.Organization, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve organization %q: %v", options.Organization, err)
+	}
+
+	e := &exporter{
+		client:    c,
+		files:     map[string]*hclwrite.File{},
+		imports:   hclwrite.NewEmptyFile(),
+		variables: hclwrite.NewEmptyFile(),
+		names:     map[string]map[int64]string{},
+		used:      map[string]map[string]bool{},
+	}
+	appendComment(e.imports.Body(), "Import blocks need Terraform 1.5 or later. They can be removed once the objects are in state.")
+	appendComment(e.variables.Body(), "Secrets the controller does not return. The first apply writes these values to the controller, replacing the stored secrets.")
+
+	for _, kind := range exportKinds {
+		if err := e.exportKind(ctx, kind, organizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	return e.addresses, e.write(options.Directory)
+}
+
+func (e *exporter) exportKind(ctx context.Context, kind exportKind, organizationID int) error {
+	managed := kind.newResource()
+
+	metadataResp := &resource.MetadataResponse{}
+	managed.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: configprefix.Prefix}, metadataResp)
+	typeName := metadataResp.TypeName
+
+	schemaResp := &resource.SchemaResponse{}
+	managed.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if err := diagsError(schemaResp.Diagnostics); err != nil {
+		return err
+	}
+
+	if kind.parent == "" {
+		query := urlParser.Values{}
+		query.Set(kind.filter, strconv.Itoa(organizationID))
+		query.Set("order_by", "name")
+
+		objects, err := e.list(ctx, fmt.Sprintf("%s/?%s", kind.collection, query.Encode()))
+		if err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			if err := e.exportObject(ctx, kind, typeName, schemaResp.Schema, nil, object); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	parentNames := e.names[configprefix.Prefix+"_"+kind.parent]
+	for _, parentID := range slices.Sorted(maps.Keys(parentNames)) {
+		objects, err := e.list(ctx, fmt.Sprintf(kind.collection, parentID)+"/?order_by=name")
+		if err != nil {
+			return err
+		}
+
+		parent := &exportedObject{Id: parentID, Name: parentNames[parentID]}
+		for _, object := range objects {
+			if err := e.exportObject(ctx, kind, typeName, schemaResp.Schema, parent, object); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// An object listed by the exporter. For a parent, Name is its resource name.
+type exportedObject struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (e *exporter) list(ctx context.Context, url string) ([]exportedObject, error) {
+	body, _, err := e.client.ListAPIRequest(ctx, url, []int{200}, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to list %s: %v", url, err)
+	}
+
+	listResult := struct {
+		Results []exportedObject `json:"results"`
+	}{}
+	err = json.Unmarshal(body, &listResult)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal %s list: %v", url, err)
+	}
+
+	return listResult.Results, nil
+}
+
+// Reads one listed object into state and writes its resource and import blocks. parent is only set for kinds
+// listed from a parent's sub-collection.
+func (e *exporter) exportObject(ctx context.Context, kind exportKind, typeName string, resourceSchema schema.Schema, parent *exportedObject, object exportedObject) error {
+	idAttribute := kind.idAttribute
+	if idAttribute == "" {
+		idAttribute = "id"
+	}
+
+	id := strconv.FormatInt(object.Id, 10)
+	importID, displayName := id, object.Name
+
+	state := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	diags := state.SetAttribute(ctx, path.Root(idAttribute), id)
+	if kind.parentAttribute != "" {
+		diags.Append(state.SetAttribute(ctx, path.Root(kind.parentAttribute), strconv.FormatInt(parent.Id, 10))...)
+		importID = fmt.Sprintf("%d/%d", parent.Id, object.Id)
+		displayName = parent.Name + "_" + object.Name
+	}
+	for name, value := range kind.initialState {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if err := diagsError(diags); err != nil {
+		return err
+	}
+
+	state, diags = readManagedResource(ctx, e.client, kind.newResource(), state)
+	if err := diagsError(diags); err != nil {
+		return fmt.Errorf("unable to read %s %q: %v", typeName, displayName, err)
+	}
+	if state.Raw.IsNull() {
+		return nil
+	}
+
+	var values map[string]tftypes.Value
+	if err := state.Raw.As(&values); err != nil {
+		return err
+	}
+
+	if kind.skipWhenEmpty != "" {
+		var elements []tftypes.Value
+		if err := values[kind.skipWhenEmpty].As(&elements); err != nil {
+			return err
+		}
+		if len(elements) == 0 {
+			return nil
+		}
+	}
+
+	name := e.resourceName(typeName, object.Id, displayName)
+	if err := e.writeResource(typeName, name, displayName, kind, resourceSchema, values); err != nil {
+		return fmt.Errorf("unable to write %s %q: %v", typeName, displayName, err)
+	}
+
+	importBlock := e.imports.Body().AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: typeName}, hcl.TraverseAttr{Name: name}})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importID))
+	e.imports.Body().AppendNewline()
+
+	e.addresses = append(e.addresses, typeName+"."+name)
+
+	return nil
+}
+
+// Picks a resource name for the object from its display name, unique within the resource type, and records it
+// so later kinds can reference the object by id.
+func (e *exporter) resourceName(typeName string, id int64, displayName string) string {
+	base := exportName(displayName)
+
+	if e.used[typeName] == nil {
+		e.used[typeName] = map[string]bool{}
+		e.names[typeName] = map[int64]string{}
+	}
+
+	name := base
+	for i := 2; e.used[typeName][name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	e.used[typeName][name] = true
+	e.names[typeName][id] = name
+
+	return name
+}
+
+// Lower cases s and replaces whatever isn't valid in a Terraform name with `_`.
+func exportName(s string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if name == "" || !hclsyntax.ValidIdentifier(name) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// Appends a resource block with every configurable attribute that has a value in state.
+func (e *exporter) writeResource(typeName, name, displayName string, kind exportKind, resourceSchema schema.Schema, values map[string]tftypes.Value) error {
+	file, ok := e.files[kind.fileName]
+	if !ok {
+		file = hclwrite.NewEmptyFile()
+		e.files[kind.fileName] = file
+		e.fileNames = append(e.fileNames, kind.fileName)
+	}
+
+	block := file.Body().AppendNewBlock("resource", []string{typeName, name})
+
+	for _, attributeName := range slices.Sorted(maps.Keys(resourceSchema.Attributes)) {
+		attribute := resourceSchema.Attributes[attributeName]
+		value := values[attributeName]
+
+		if (!attribute.IsRequired() && !attribute.IsOptional()) || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+
+		target := attributeValue{
+			reference: kind.references[attributeName],
+			variable:  strings.TrimPrefix(typeName, configprefix.Prefix+"_") + "_" + name + "_" + attributeName,
+			secretOf:  fmt.Sprintf("%s %q", strings.TrimPrefix(typeName, configprefix.Prefix+"_"), displayName),
+		}
+
+		tokens, err := e.valueTokens(value, target)
+		if err != nil {
+			return fmt.Errorf("attribute %s: %v", attributeName, err)
+		}
+
+		block.Body().SetAttributeRaw(attributeName, tokens)
+	}
+
+	file.Body().AppendNewline()
+
+	return nil
+}
+
+// How one attribute's value is written.
+type attributeValue struct {
+	// resource type, without the provider prefix, of the objects the attribute's ids refer to, if any
+	reference string
+	// name of the variable an encrypted value is read from; nested values append their key
+	variable string
+	// the object an encrypted value belongs to, for the variable's description
+	secretOf string
+}
+
+// Writes a value of state as an HCL expression. Ids of exported objects become references to their resource,
+// and encrypted secrets become sensitive variables.
+func (e *exporter) valueTokens(value tftypes.Value, target attributeValue) (hclwrite.Tokens, error) {
+	switch {
+	case value.IsNull():
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+
+	case value.Type().Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+
+		if id, err := strconv.ParseInt(s, 10, 64); err == nil {
+			if tokens, ok := e.referenceTokens(target.reference, id); ok {
+				return tokens, nil
+			}
+		}
+
+		if s == encryptedValue {
+			return e.variableTokens(target), nil
+		}
+
+		return hclwrite.TokensForValue(cty.StringVal(s)), nil
+
+	case value.Type().Is(tftypes.Number):
+		var number big.Float
+		if err := value.As(&number); err != nil {
+			return nil, err
+		}
+
+		if id, accuracy := number.Int64(); accuracy == big.Exact {
+			if tokens, ok := e.referenceTokens(target.reference, id); ok {
+				return tokens, nil
+			}
+		}
+
+		return hclwrite.TokensForValue(cty.NumberVal(&number)), nil
+
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		tuple := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			tokens, err := e.valueTokens(element, target)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, tokens)
+		}
+
+		return hclwrite.TokensForTuple(tuple), nil
+
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		object := make([]hclwrite.ObjectAttrTokens, 0, len(elements))
+		for _, key := range slices.Sorted(maps.Keys(elements)) {
+			elementTarget := target
+			elementTarget.variable = target.variable + "_" + exportName(key)
+
+			tokens, err := e.valueTokens(elements[key], elementTarget)
+			if err != nil {
+				return nil, err
+			}
+
+			nameTokens := hclwrite.TokensForValue(cty.StringVal(key))
+			if hclsyntax.ValidIdentifier(key) {
+				nameTokens = hclwrite.TokensForIdentifier(key)
+			}
+
+			object = append(object, hclwrite.ObjectAttrTokens{Name: nameTokens, Value: tokens})
+		}
+
+		return hclwrite.TokensForObject(object), nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %s", value.Type())
+}
+
+// Returns `<type>.<name>.id` when the object with id was exported as a resource of the referenced type.
+func (e *exporter) referenceTokens(reference string, id int64) (hclwrite.Tokens, bool) {
+	if reference == "" {
+		return nil, false
+	}
+
+	typeName := configprefix.Prefix + "_" + reference
+	name, ok := e.names[typeName][id]
+	if !ok {
+		return nil, false
+	}
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: name},
+		hcl.TraverseAttr{Name: "id"},
+	}), true
+}
+
+// Declares a sensitive variable for an encrypted secret and returns a reference to it.
+func (e *exporter) variableTokens(target attributeValue) hclwrite.Tokens {
+	e.hasVariables = true
+
+	block := e.variables.Body().AppendNewBlock("variable", []string{target.variable})
+	block.Body().SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+	block.Body().SetAttributeValue("sensitive", cty.True)
+	block.Body().SetAttributeValue("description", cty.StringVal(fmt.Sprintf("Secret of %s, which the controller does not return.", target.secretOf)))
+	e.variables.Body().AppendNewline()
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: target.variable},
+	})
+}
+
+func appendComment(body *hclwrite.Body, comment string) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte("# " + comment + "\n")}})
+	body.AppendNewline()
+}
+
+// Writes the resource files along with the import blocks, the variables for secrets and the provider
+// requirements.
+func (e *exporter) write(directory string) error {
+	versions := hclwrite.NewEmptyFile()
+	terraform := versions.Body().AppendNewBlock("terraform", nil)
+	terraform.Body().SetAttributeValue("required_version", cty.StringVal(">= 1.5.0"))
+	requiredProviders := terraform.Body().AppendNewBlock("required_providers", nil)
+	requiredProviders.Body().SetAttributeValue(configprefix.Prefix, cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("tfbrew/" + configprefix.Prefix),
+	}))
+
+	files := map[string]*hclwrite.File{
+		"versions.tf": versions,
+		"imports.tf":  e.imports,
+	}
+	if e.hasVariables {
+		files["variables.tf"] = e.variables
+	}
+	for _, fileName := range e.fileNames {
+		files[fileName] = e.files[fileName]
+	}
+
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return err
+	}
+
+	for fileName, file := range files {
+		content := append(bytes.TrimRight(hclwrite.Format(file.Bytes()), "\n"), '\n')
+		if err := os.WriteFile(filepath.Join(directory, fileName), content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
+)
+
+func TestExport(t *testing.T) {
+	fake := newFakeController(t)
+	defaultID := fake.seed("organizations", map[string]any{"name": "Default"})
+	otherID := fake.seed("organizations", map[string]any{"name": "Other"})
+	machineID := fake.seed("credential_types", map[string]any{"name": "Machine", "kind": "ssh"})
+	credentialID := fake.seed("credentials", map[string]any{
+		"name":            "Deploy Key",
+		"organization":    defaultID,
+		"credential_type": machineID,
+		"kind":            "ssh",
+		"inputs":          map[string]any{"username": "deploy", "ssh_key_data": "$encrypted$"},
+	})
+	fake.seed("credentials", map[string]any{"name": "elsewhere", "organization": otherID, "credential_type": machineID})
+	projectID := fake.seed("projects", map[string]any{"name": "playbooks", "organization": defaultID, "scm_type": "git", "credential": credentialID})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "servers", "organization": defaultID})
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy", "organization": defaultID, "project": projectID, "inventory": inventoryID, "playbook": "site.yml"})
+	fake.seed("job_templates", map[string]any{"name": "Deploy", "organization": defaultID, "project": projectID, "playbook": "site.yml"})
+	hostID := fake.seed("hosts", map[string]any{"name": "web1", "inventory": inventoryID, "enabled": true})
+	groupID := fake.seed("groups", map[string]any{"name": "web", "inventory": inventoryID})
+	fake.associations[associationKey("groups", groupID, "hosts")] = []int64{hostID}
+	fake.seed("inventory_sources", map[string]any{"name": "from git", "inventory": inventoryID, "source": "scm", "source_project": projectID, "source_path": "hosts.yml"})
+	labelID := fake.seed("labels", map[string]any{"name": "production", "organization": defaultID})
+	fake.associations[associationKey("job_templates", jobTemplateID, "labels")] = []int64{labelID}
+	fake.seed("schedules", map[string]any{"name": "nightly", "unified_job_template": jobTemplateID, "rrule": "DTSTART:20260101T000000Z RRULE:FREQ=DAILY", "enabled": true})
+
+	client := fake.client()
+
+	associations := newResourceHarness[JobTemplateCredentialResourceModel](t, NewJobTemplateCredentialResource, client)
+	credentialIDs, _ := types.SetValueFrom(context.Background(), types.Int32Type, []int32{int32(credentialID)})
+	associations.create(JobTemplateCredentialResourceModel{
+		JobTemplateId: types.StringValue(fmt.Sprint(jobTemplateID)),
+		CredentialIds: credentialIDs,
+	})
+
+	directory := t.TempDir()
+	addresses, err := client.export(context.Background(), ExportOptions{Organization: "Default", Directory: directory})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedAddresses := []string{
+		"awx_organization.default",
+		"awx_credential.deploy_key",
+		"awx_label.production",
+		"awx_project.playbooks",
+		"awx_inventory.servers",
+		"awx_host.web1",
+		"awx_group.web",
+		"awx_group_host.web_web1",
+		"awx_inventory_source.from_git",
+		"awx_job_template.deploy",
+		"awx_job_template.deploy_2",
+		"awx_job_template_credential.deploy",
+		"awx_job_template_label.deploy",
+		"awx_schedule.nightly",
+	}
+	for i := range expectedAddresses {
+		expectedAddresses[i] = withPrefix(expectedAddresses[i])
+	}
+	if !slices.Equal(addresses, expectedAddresses) {
+		t.Errorf("expected addresses %v, got %v", expectedAddresses, addresses)
+	}
+
+	parser := hclparse.NewParser()
+	files := map[string]string{}
+	for _, fileName := range []string{"versions.tf", "imports.tf", "variables.tf", "organization.tf", "credentials.tf", "projects.tf", "inventories.tf", "job_templates.tf", "labels.tf", "schedules.tf"} {
+		content, err := os.ReadFile(filepath.Join(directory, fileName))
+		if err != nil {
+			t.Fatalf("reading %s: %v", fileName, err)
+		}
+		if _, diags := parser.ParseHCL(content, fileName); diags.HasErrors() {
+			t.Errorf("%s is not valid HCL: %v\n%s", fileName, diags, content)
+		}
+		files[fileName] = string(content)
+	}
+
+	expectedContent := map[string][]string{
+		"imports.tf": {
+			"to = awx_project.playbooks\n  id = \"" + fmt.Sprint(projectID) + "\"",
+			"to = awx_job_template_credential.deploy\n  id = \"" + fmt.Sprint(jobTemplateID) + "\"",
+			"to = awx_group_host.web_web1\n  id = \"" + fmt.Sprint(groupID) + "/" + fmt.Sprint(hostID) + "\"",
+		},
+		"credentials.tf": {
+			"organization = awx_organization.default.id",
+			"credential_type = " + fmt.Sprint(machineID),
+			"ssh_key_data = var.credential_deploy_key_inputs_ssh_key_data",
+			`username     = "deploy"`,
+		},
+		"variables.tf": {
+			`variable "credential_deploy_key_inputs_ssh_key_data" {`,
+			"sensitive   = true",
+		},
+		"projects.tf": {
+			"credential               = awx_credential.deploy_key.id",
+			"organization             = awx_organization.default.id",
+		},
+		"inventories.tf": {
+			"inventory = awx_inventory.servers.id",
+			"group_id = awx_group.web.id",
+			"host_id  = awx_host.web1.id",
+			"source_project   = awx_project.playbooks.id",
+		},
+		"schedules.tf": {
+			"unified_job_template = awx_job_template.deploy.id",
+		},
+		"job_templates.tf": {
+			"label_ids       = [awx_label.production.id]",
+			"inventory                           = awx_inventory.servers.id",
+			"project                             = awx_project.playbooks.id",
+			"credential_ids  = [awx_credential.deploy_key.id]",
+			"job_template_id = awx_job_template.deploy.id",
+		},
+	}
+	for fileName, snippets := range expectedContent {
+		for _, snippet := range snippets {
+			snippet = withPrefix(snippet)
+			if !strings.Contains(files[fileName], snippet) {
+				t.Errorf("expected %s to contain %q, got:\n%s", fileName, snippet, files[fileName])
+			}
+		}
+	}
+
+	if unsupported := ExportUnsupportedTypes(); !slices.Contains(unsupported, withPrefix("awx_team")) || slices.Contains(unsupported, withPrefix("awx_host")) {
+		t.Errorf("unexpected unsupported resource types: %v", unsupported)
+	}
+
+	if strings.Contains(files["credentials.tf"], "elsewhere") {
+		t.Errorf("expected only objects of the exported organization, got:\n%s", files["credentials.tf"])
+	}
+
+	if _, err := client.export(context.Background(), ExportOptions{Organization: "Missing", Directory: directory}); err == nil || !strings.Contains(err.Error(), `unable to resolve organization "Missing"`) {
+		t.Errorf("expected an error for a missing organization, got: %v", err)
+	}
+}
+
+// The examples above are written for the awx provider; this swaps in the prefix of the provider under test.
+func withPrefix(s string) string {
+	return strings.ReplaceAll(s, "awx_", configprefix.Prefix+"_")
+}
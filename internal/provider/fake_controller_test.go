@@ -0,0 +1,799 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// The token the fake controller accepts. Requests without it are answered with 401.
+const fakeControllerToken = "fake-controller-token"
+
+// Required fields of the collections the fake validates on create and update.
+var fakeRequiredFields = map[string][]string{
+	"organizations": {"name"},
+	"inventories":   {"name", "organization"},
+	"hosts":         {"name", "inventory"},
+	"groups":        {"name", "inventory"},
+	"projects":      {"name"},
+	"credentials":   {"name", "credential_type"},
+	"labels":        {"name", "organization"},
+	"job_templates": {"name", "project", "playbook"},
+}
+
+// Fields holding the id of an object in another collection, which must exist.
+var fakeReferenceFields = map[string]map[string]string{
+	"inventories":   {"organization": "organizations"},
+	"hosts":         {"inventory": "inventories"},
+	"groups":        {"inventory": "inventories"},
+	"projects":      {"organization": "organizations"},
+	"credentials":   {"organization": "organizations"},
+	"labels":        {"organization": "organizations"},
+	"job_templates": {"inventory": "inventories", "project": "projects"},
+}
+
+// Fields whose values, together, must be unique within a collection.
+var fakeUniqueFields = map[string][]string{
+	"organizations": {"name"},
+	"inventories":   {"name", "organization"},
+	"hosts":         {"name", "inventory"},
+	"groups":        {"name", "inventory"},
+	"projects":      {"name", "organization"},
+	"job_templates": {"name", "organization"},
+}
+
+// Fields the controller fills in on create when the request leaves them out.
+var fakeDefaultFields = map[string]map[string]any{
+	"projects": {"local_path": "", "scm_url": "", "scm_revision": "", "playbooks": []any{}},
+}
+
+// Fields of a project that start a source control sync when they change.
+var fakeProjectSourceFields = []string{"scm_type", "scm_url", "scm_branch", "scm_refspec", "credential"}
+
+// Sub-collections served straight from a field of the object, i.e. /projects/1/playbooks/.
+var fakeFieldSubCollections = map[string][]string{
+	"projects": {"playbooks"},
+}
+
+// Sub-collections of an object that list the children pointing back at it through a field, i.e.
+// /inventories/1/hosts/ lists the hosts whose inventory is 1.
+var fakeChildCollections = map[string]map[string]string{
+	"inventories":   {"hosts": "inventory", "groups": "inventory", "inventory_sources": "inventory"},
+	"job_templates": {"schedules": "unified_job_template"},
+	"organizations": {"inventories": "organization", "projects": "organization"},
+	"workflow_jobs": {"workflow_nodes": "workflow_job"},
+}
+
+// The status a workflow approval, and the workflow job it belongs to, end up with after each decision.
+var fakeApprovalDecisions = map[string]string{
+	"approve": "successful",
+	"deny":    "failed",
+}
+
+// Sub-collections that hold associations, keyed by the collection of the associated objects when it differs
+// from the sub-collection name.
+var fakeAssociationTargets = map[string]string{
+	"notification_templates_error":     "notification_templates",
+	"notification_templates_started":   "notification_templates",
+	"notification_templates_success":   "notification_templates",
+	"notification_templates_approvals": "notification_templates",
+	"galaxy_credentials":               "credentials",
+	"success_nodes":                    "workflow_job_template_nodes",
+	"failure_nodes":                    "workflow_job_template_nodes",
+	"always_nodes":                     "workflow_job_template_nodes",
+}
+
+// Sub-collections that launch a unified job, and the collection the job is created in.
+var fakeLaunchCollections = map[string]map[string]string{
+	"inventory_sources":      {"update": "inventory_updates"},
+	"job_templates":          {"launch": "jobs"},
+	"projects":               {"update": "project_updates"},
+	"workflow_job_templates": {"launch": "workflow_jobs"},
+}
+
+// The status each launching sub-collection answers with; updates are accepted rather than created.
+var fakeLaunchStatusCodes = map[string]int{
+	"launch": http.StatusCreated,
+	"update": http.StatusAccepted,
+}
+
+// The field of a launched job holding the id of what launched it.
+var fakeLaunchedFrom = map[string]string{
+	"inventory_updates": "inventory_source",
+	"jobs":              "job_template",
+	"project_updates":   "project",
+	"workflow_jobs":     "workflow_job_template",
+}
+
+// A minimal in-memory implementation of the AWX /api/v2/ surface for tests that can't reach a real
+// controller. Any collection can be created and read; the ones in fakeRequiredFields, fakeReferenceFields and
+// fakeUniqueFields are validated the way the controller does, answering 400 with field errors. Lists are
+// paginated and filterable by field, unknown objects are answered with 404, and association sub-collections
+// accept the {"id": ..., "disassociate": true} requests used by the association resources. Launched jobs start out
+// pending, and finish with the fields of jobOutcome once they have been read, so callers waiting on them poll at
+// least once, and projects with source control start a sync the same way on create and when their source changes.
+// The output of any object is served from its "stdout" field.
+type fakeController struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	nextID       int64
+	objects      map[string]map[int64]map[string]any
+	associations map[string][]int64
+	requests     []string
+
+	// The fields a launched job finishes with; {"status": "successful"} when nil.
+	jobOutcome  map[string]any
+	pendingJobs map[string]map[string]any
+
+	// Called with each launched job, i.e. to add the nodes of a workflow job. The fake is locked while it runs,
+	// so it must use insert rather than seed.
+	onLaunch func(jobCollection string, jobID int64)
+}
+
+// Starts a fake controller that is shut down when the test ends.
+func newFakeController(t *testing.T) *fakeController {
+	t.Helper()
+
+	fake := &fakeController{
+		objects:      map[string]map[int64]map[string]any{},
+		associations: map[string][]int64{},
+		pendingJobs:  map[string]map[string]any{},
+	}
+	fake.server = httptest.NewServer(http.HandlerFunc(fake.serveHTTP))
+	t.Cleanup(fake.server.Close)
+
+	return fake
+}
+
+func (f *fakeController) URL() string {
+	return f.server.URL
+}
+
+// Returns a providerClient pointed at the fake, configured the way Configure() would for an AWX endpoint.
+func (f *fakeController) client() *providerClient {
+	client := &providerClient{
+		client:   f.server.Client(),
+		endpoint: f.server.URL,
+		auth:     "Bearer " + fakeControllerToken,
+	}
+	client.setPlatform(platformAWX)
+
+	return client
+}
+
+// Points the provider at the fake through its environment variables, for tests run with resource.UnitTest.
+func (f *fakeController) protoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Setenv("TOWER_HOST", f.server.URL)
+	t.Setenv("TOWER_OAUTH_TOKEN", fakeControllerToken)
+	t.Setenv("TOWER_PLATFORM", platformAWX)
+
+	return testAccProtoV6ProviderFactories
+}
+
+// Stores an object directly, bypassing validation, and returns its id.
+func (f *fakeController) seed(collection string, fields map[string]any) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.insert(collection, fields)
+}
+
+// Returns a copy of a stored object, or nil when it doesn't exist.
+func (f *fakeController) get(collection string, id int64) map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	object, ok := f.objects[collection][id]
+	if !ok {
+		return nil
+	}
+
+	return maps.Clone(object)
+}
+
+// Returns the ids associated with an object through a sub-collection, i.e. ("job_templates", 1, "labels").
+func (f *fakeController) associated(collection string, id int64, subCollection string) []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.associations[associationKey(collection, id, subCollection)])
+}
+
+// Returns the "METHOD path?query" of every request served so far.
+func (f *fakeController) requestLog() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.requests)
+}
+
+func (f *fakeController) insert(collection string, fields map[string]any) int64 {
+	f.nextID++
+	id := f.nextID
+
+	object := map[string]any{
+		"description": "",
+	}
+	maps.Copy(object, fields)
+	object["id"] = id
+	object["type"] = fakeObjectType(collection)
+	object["url"] = fmt.Sprintf("/api/v2/%s/%d/", collection, id)
+	object["created"] = time.Now().UTC().Format(time.RFC3339)
+	object["related"] = map[string]any{}
+	object["summary_fields"] = map[string]any{}
+
+	if f.objects[collection] == nil {
+		f.objects[collection] = map[int64]map[string]any{}
+	}
+	f.objects[collection][id] = object
+
+	return id
+}
+
+// The singular type name the controller reports for objects of a collection, i.e. "inventory".
+func fakeObjectType(collection string) string {
+	if singular, ok := strings.CutSuffix(collection, "ies"); ok {
+		return singular + "y"
+	}
+
+	return strings.TrimSuffix(collection, "s")
+}
+
+func associationKey(collection string, id int64, subCollection string) string {
+	return fmt.Sprintf("%s/%d/%s", collection, id, subCollection)
+}
+
+func (f *fakeController) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI())
+
+	switch r.URL.Path {
+	case "/api/":
+		writeFakeJSON(w, http.StatusOK, map[string]any{"description": "AWX REST API", "current_version": "/api/v2/"})
+		return
+	case "/api/v2/ping/":
+		writeFakeJSON(w, http.StatusOK, map[string]any{"version": "24.6.1", "active_node": "awx-1"})
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+fakeControllerToken {
+		writeFakeJSON(w, http.StatusUnauthorized, map[string]any{"detail": "Authentication credentials were not provided."})
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/v2/config/":
+		writeFakeJSON(w, http.StatusOK, map[string]any{"version": "24.6.1", "license_info": map[string]any{"license_type": "open"}})
+		return
+	case "/api/v2/me/":
+		writeFakeJSON(w, http.StatusOK, map[string]any{"count": 1, "results": []any{map[string]any{"id": 1, "username": "admin"}}})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/"), "/")
+	if !strings.HasPrefix(r.URL.Path, "/api/v2/") || parts[0] == "" || len(parts) > 3 {
+		writeFakeNotFound(w)
+		return
+	}
+
+	collection := parts[0]
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			f.list(w, r, collection, f.allObjects(collection))
+		case http.MethodPost:
+			f.create(w, r, collection, nil)
+		default:
+			writeFakeJSON(w, http.StatusMethodNotAllowed, map[string]any{"detail": fmt.Sprintf("Method \"%s\" not allowed.", r.Method)})
+		}
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	object, exists := f.objects[collection][id]
+	if err != nil || !exists {
+		writeFakeNotFound(w)
+		return
+	}
+
+	if len(parts) == 3 {
+		f.serveSubCollection(w, r, collection, id, parts[2])
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, object)
+		if outcome, ok := f.pendingJobs[associationKey(collection, id, "")]; ok {
+			maps.Copy(object, outcome)
+			delete(f.pendingJobs, associationKey(collection, id, ""))
+			if collection == "project_updates" {
+				f.finishProjectSync(object["project"].(int64), id)
+			}
+		}
+	case http.MethodPut, http.MethodPatch:
+		f.update(w, r, collection, id, object)
+	case http.MethodDelete:
+		if activeJobs := f.activeJobs(collection, id); len(activeJobs) > 0 {
+			writeFakeJSON(w, http.StatusConflict, map[string]any{"error": "Resource is being used by running jobs.", "active_jobs": activeJobs})
+			return
+		}
+		delete(f.objects[collection], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeJSON(w, http.StatusMethodNotAllowed, map[string]any{"detail": fmt.Sprintf("Method \"%s\" not allowed.", r.Method)})
+	}
+}
+
+func (f *fakeController) serveSubCollection(w http.ResponseWriter, r *http.Request, collection string, id int64, subCollection string) {
+	if jobCollection, ok := fakeLaunchCollections[collection][subCollection]; ok {
+		f.launch(w, r, jobCollection, fakeLaunchStatusCodes[subCollection], map[string]any{fakeLaunchedFrom[jobCollection]: id})
+		return
+	}
+
+	if subCollection == "stdout" && r.Method == http.MethodGet {
+		f.stdout(w, r, f.objects[collection][id])
+		return
+	}
+
+	if slices.Contains(fakeFieldSubCollections[collection], subCollection) && r.Method == http.MethodGet {
+		writeFakeJSON(w, http.StatusOK, f.objects[collection][id][subCollection])
+		return
+	}
+
+	if status, ok := fakeApprovalDecisions[subCollection]; ok && collection == "workflow_approvals" && r.Method == http.MethodPost {
+		f.decideApproval(w, id, status)
+		return
+	}
+
+	if parentField, ok := fakeChildCollections[collection][subCollection]; ok {
+		switch r.Method {
+		case http.MethodGet:
+			var children []map[string]any
+			for _, child := range f.allObjects(subCollection) {
+				if fmt.Sprint(child[parentField]) == strconv.FormatInt(id, 10) {
+					children = append(children, child)
+				}
+			}
+			f.list(w, r, subCollection, children)
+		case http.MethodPost:
+			f.create(w, r, subCollection, map[string]any{parentField: id})
+		default:
+			writeFakeJSON(w, http.StatusMethodNotAllowed, map[string]any{"detail": fmt.Sprintf("Method \"%s\" not allowed.", r.Method)})
+		}
+		return
+	}
+
+	target := subCollection
+	if mapped, ok := fakeAssociationTargets[subCollection]; ok {
+		target = mapped
+	}
+	key := associationKey(collection, id, subCollection)
+
+	switch r.Method {
+	case http.MethodGet:
+		var associated []map[string]any
+		for _, associatedID := range f.associations[key] {
+			if object, ok := f.objects[target][associatedID]; ok {
+				associated = append(associated, object)
+			}
+		}
+		f.list(w, r, target, associated)
+	case http.MethodPost:
+		request := struct {
+			ID           *int64 `json:"id"`
+			Disassociate bool   `json:"disassociate"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.ID == nil {
+			writeFakeJSON(w, http.StatusBadRequest, map[string]any{"msg": "\"id\" field is required to associate or disassociate."})
+			return
+		}
+
+		if _, ok := f.objects[target][*request.ID]; !ok {
+			writeFakeJSON(w, http.StatusBadRequest, map[string]any{"msg": fmt.Sprintf("Object with id %d not found.", *request.ID)})
+			return
+		}
+
+		if request.Disassociate {
+			f.associations[key] = slices.DeleteFunc(f.associations[key], func(existing int64) bool { return existing == *request.ID })
+		} else if !slices.Contains(f.associations[key], *request.ID) {
+			f.associations[key] = append(f.associations[key], *request.ID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeJSON(w, http.StatusMethodNotAllowed, map[string]any{"detail": fmt.Sprintf("Method \"%s\" not allowed.", r.Method)})
+	}
+}
+
+// Creates a pending job from the launch request, answering the way the launch endpoints do.
+func (f *fakeController) launch(w http.ResponseWriter, r *http.Request, jobCollection string, statusCode int, launchedFrom map[string]any) {
+	if r.Method != http.MethodPost {
+		writeFakeJSON(w, http.StatusMethodNotAllowed, map[string]any{"detail": fmt.Sprintf("Method \"%s\" not allowed.", r.Method)})
+		return
+	}
+
+	fields := map[string]any{}
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil && !errors.Is(err, io.EOF) {
+		writeFakeJSON(w, http.StatusBadRequest, map[string]any{"detail": "JSON parse error - " + err.Error()})
+		return
+	}
+	maps.Copy(fields, launchedFrom)
+	fields["status"] = "pending"
+	fields["failed"] = false
+	fields["elapsed"] = 0
+
+	jobID := f.insert(jobCollection, fields)
+	f.startJob(jobCollection, jobID)
+
+	response := maps.Clone(f.objects[jobCollection][jobID])
+	response[fakeObjectType(jobCollection)] = jobID
+	response["ignored_fields"] = map[string]any{}
+	writeFakeJSON(w, statusCode, response)
+}
+
+// Answers the way the stdout endpoints do with ?format=json, with the lines of the job's output from start_line on.
+func (f *fakeController) stdout(w http.ResponseWriter, r *http.Request, job map[string]any) {
+	lines := strings.SplitAfter(fmt.Sprint(job["stdout"]), "\n")
+	if job["stdout"] == nil {
+		lines = nil
+	} else if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("start_line"))
+	start = min(max(start, 0), len(lines))
+
+	writeFakeJSON(w, http.StatusOK, map[string]any{
+		"range":   map[string]any{"start": start, "end": len(lines), "absolute_end": len(lines)},
+		"content": strings.Join(lines[start:], ""),
+	})
+}
+
+// Leaves a pending job to finish with jobOutcome once it's read.
+func (f *fakeController) startJob(jobCollection string, jobID int64) {
+	outcome := f.jobOutcome
+	if outcome == nil {
+		outcome = map[string]any{"status": "successful"}
+	}
+	f.pendingJobs[associationKey(jobCollection, jobID, "")] = outcome
+
+	if f.onLaunch != nil {
+		f.onLaunch(jobCollection, jobID)
+	}
+}
+
+// Starts a source control sync of a project, as the controller does when one is created or its source changes.
+// Manual projects don't sync.
+func (f *fakeController) syncProject(id int64) {
+	project := f.objects["projects"][id]
+	if scmType, _ := project["scm_type"].(string); scmType == "" {
+		return
+	}
+
+	updateID := f.insert("project_updates", map[string]any{"project": id, "status": "pending", "failed": false, "elapsed": 0})
+	f.startJob("project_updates", updateID)
+	project["summary_fields"].(map[string]any)["current_update"] = map[string]any{"id": updateID, "status": "pending"}
+}
+
+// Moves a project's finished sync from its current_update to its last_update, as the controller does.
+func (f *fakeController) finishProjectSync(projectID, updateID int64) {
+	summaryFields := f.objects["projects"][projectID]["summary_fields"].(map[string]any)
+	if current, ok := summaryFields["current_update"].(map[string]any); ok && current["id"] == updateID {
+		delete(summaryFields, "current_update")
+		summaryFields["last_update"] = map[string]any{"id": updateID, "status": f.objects["project_updates"][updateID]["status"]}
+	}
+}
+
+// Lists the jobs launched from an object that haven't finished, which keep the controller from deleting it.
+func (f *fakeController) activeJobs(collection string, id int64) []map[string]any {
+	var activeJobs []map[string]any
+	for jobCollection, launchedFrom := range fakeLaunchedFrom {
+		if launchedFrom != fakeObjectType(collection) {
+			continue
+		}
+		for jobID, job := range f.objects[jobCollection] {
+			if _, pending := f.pendingJobs[associationKey(jobCollection, jobID, "")]; pending && job[launchedFrom] == id {
+				activeJobs = append(activeJobs, map[string]any{"type": fakeObjectType(jobCollection), "id": jobID})
+			}
+		}
+	}
+
+	return activeJobs
+}
+
+// Approves or denies a pending workflow approval. The workflow job it belongs to, if any, stops waiting on it and
+// finishes with the same status, as if the approval was its last node.
+func (f *fakeController) decideApproval(w http.ResponseWriter, id int64, status string) {
+	approval := f.objects["workflow_approvals"][id]
+	if approval["status"] != "pending" {
+		writeFakeJSON(w, http.StatusBadRequest, map[string]any{"error": "This workflow step has already been approved or denied."})
+		return
+	}
+	approval["status"] = status
+
+	if workflowJobID, ok := approval["workflow_job"].(int64); ok {
+		if workflowJob, ok := f.objects["workflow_jobs"][workflowJobID]; ok {
+			workflowJob["status"] = status
+			delete(f.pendingJobs, associationKey("workflow_jobs", workflowJobID, ""))
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Sets the fields launched jobs finish with.
+func (f *fakeController) setJobOutcome(outcome map[string]any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.jobOutcome = outcome
+}
+
+func (f *fakeController) allObjects(collection string) []map[string]any {
+	objects := make([]map[string]any, 0, len(f.objects[collection]))
+	for _, id := range slices.Sorted(maps.Keys(f.objects[collection])) {
+		objects = append(objects, f.objects[collection][id])
+	}
+
+	return objects
+}
+
+// Writes a page of objects, filtered by every query parameter other than page, page_size, order_by and search.
+func (f *fakeController) list(w http.ResponseWriter, r *http.Request, collection string, objects []map[string]any) {
+	query := r.URL.Query()
+
+	filtered := []map[string]any{}
+	for _, object := range objects {
+		matches := true
+		for key, values := range query {
+			switch key {
+			case "page", "page_size", "order_by":
+			case "search":
+				matches = matches && strings.Contains(strings.ToLower(fmt.Sprint(object["name"])), strings.ToLower(values[0]))
+			default:
+				matches = matches && fmt.Sprint(object[key]) == values[0]
+			}
+		}
+		if matches {
+			filtered = append(filtered, object)
+		}
+	}
+
+	pageSize := 25
+	if value, err := strconv.Atoi(query.Get("page_size")); err == nil && value > 0 {
+		pageSize = min(value, 200)
+	}
+
+	page := 1
+	if value, err := strconv.Atoi(query.Get("page")); err == nil && value > 0 {
+		page = value
+	}
+
+	start := min((page-1)*pageSize, len(filtered))
+	end := min(start+pageSize, len(filtered))
+
+	pageLink := func(page int) any {
+		linkQuery := r.URL.Query()
+		linkQuery.Set("page", strconv.Itoa(page))
+		return r.URL.Path + "?" + linkQuery.Encode()
+	}
+
+	response := map[string]any{
+		"count":    len(filtered),
+		"next":     nil,
+		"previous": nil,
+		"results":  filtered[start:end],
+	}
+	if end < len(filtered) {
+		response["next"] = pageLink(page + 1)
+	}
+	if page > 1 {
+		response["previous"] = pageLink(page - 1)
+	}
+
+	writeFakeJSON(w, http.StatusOK, response)
+}
+
+func (f *fakeController) create(w http.ResponseWriter, r *http.Request, collection string, parentFields map[string]any) {
+	var fields map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		writeFakeJSON(w, http.StatusBadRequest, map[string]any{"detail": "JSON parse error - " + err.Error()})
+		return
+	}
+	maps.Copy(fields, parentFields)
+
+	if fieldErrors := f.validate(collection, 0, fields); len(fieldErrors) > 0 {
+		writeFakeJSON(w, http.StatusBadRequest, fieldErrors)
+		return
+	}
+
+	for field, value := range fakeDefaultFields[collection] {
+		if _, ok := fields[field]; !ok {
+			fields[field] = value
+		}
+	}
+
+	id := f.insert(collection, fields)
+	if collection == "projects" {
+		f.syncProject(id)
+	}
+	writeFakeJSON(w, http.StatusCreated, f.objects[collection][id])
+}
+
+func (f *fakeController) update(w http.ResponseWriter, r *http.Request, collection string, id int64, existing map[string]any) {
+	var fields map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		writeFakeJSON(w, http.StatusBadRequest, map[string]any{"detail": "JSON parse error - " + err.Error()})
+		return
+	}
+
+	updated := maps.Clone(existing)
+	maps.Copy(updated, fields)
+	updated["id"] = id
+
+	// a PUT must carry every required field, a PATCH only the ones it changes
+	toValidate := updated
+	if r.Method == http.MethodPut {
+		toValidate = fields
+	}
+
+	if fieldErrors := f.validate(collection, id, toValidate); len(fieldErrors) > 0 {
+		writeFakeJSON(w, http.StatusBadRequest, fieldErrors)
+		return
+	}
+
+	f.objects[collection][id] = updated
+	if collection == "projects" && slices.ContainsFunc(fakeProjectSourceFields, func(field string) bool {
+		return fmt.Sprint(existing[field]) != fmt.Sprint(updated[field])
+	}) {
+		f.syncProject(id)
+	}
+	writeFakeJSON(w, http.StatusOK, updated)
+}
+
+// Returns the controller's field errors for fields, ignoring the object with id itself in uniqueness checks.
+func (f *fakeController) validate(collection string, id int64, fields map[string]any) map[string][]string {
+	fieldErrors := map[string][]string{}
+
+	for _, field := range fakeRequiredFields[collection] {
+		if value, ok := fields[field]; !ok || value == nil || value == "" || value == float64(0) {
+			fieldErrors[field] = append(fieldErrors[field], "This field is required.")
+		}
+	}
+
+	for field, target := range fakeReferenceFields[collection] {
+		value, ok := fields[field].(float64)
+		if !ok || value == 0 {
+			continue
+		}
+		if _, exists := f.objects[target][int64(value)]; !exists {
+			fieldErrors[field] = append(fieldErrors[field], fmt.Sprintf("Invalid pk \"%d\" - object does not exist.", int64(value)))
+		}
+	}
+
+	if uniqueFields, ok := fakeUniqueFields[collection]; ok && len(fieldErrors) == 0 {
+		for otherID, other := range f.objects[collection] {
+			if otherID == id {
+				continue
+			}
+			duplicate := true
+			for _, field := range uniqueFields {
+				duplicate = duplicate && fmt.Sprint(other[field]) == fmt.Sprint(fields[field])
+			}
+			if duplicate {
+				fieldErrors["__all__"] = append(fieldErrors["__all__"], fmt.Sprintf("%s with this %s already exists.", collection, strings.Join(uniqueFields, " and ")))
+				break
+			}
+		}
+	}
+
+	return fieldErrors
+}
+
+func writeFakeNotFound(w http.ResponseWriter) {
+	writeFakeJSON(w, http.StatusNotFound, map[string]any{"detail": "Not found."})
+}
+
+func writeFakeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestFakeController(t *testing.T) {
+	fake := newFakeController(t)
+	client := fake.client()
+	ctx := t.Context()
+
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+	for i := range 30 {
+		fake.seed("hosts", map[string]any{"name": fmt.Sprintf("host-%02d", i), "inventory": inventoryID})
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		body, _, err := client.GenericAPIRequest(ctx, http.MethodGet, fmt.Sprintf("inventories/%d/hosts/", inventoryID), nil, []int{200}, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var page struct {
+			Count   int              `json:"count"`
+			Next    *string          `json:"next"`
+			Results []map[string]any `json:"results"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if page.Count != 30 || len(page.Results) != 25 || page.Next == nil {
+			t.Errorf("expected the first page of 25 out of 30 hosts, got %d of %d", len(page.Results), page.Count)
+		}
+
+		// ListAPIRequest follows next, and page_size may not cover everything in one page
+		body, _, err = client.ListAPIRequest(ctx, "hosts/?page_size=7", []int{200}, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(page.Results) != 30 {
+			t.Errorf("expected every host across pages, got %d", len(page.Results))
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		body, _, err := client.ListAPIRequest(ctx, "hosts/?name=host-07", []int{200}, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(string(body), `"count":1`) {
+			t.Errorf("expected a single host, got: %s", body)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, statusCode, err := client.GenericAPIRequest(ctx, http.MethodGet, "hosts/999/", nil, []int{200, 404}, "")
+		if err != nil || statusCode != http.StatusNotFound {
+			t.Errorf("expected a 404, got %d: %v", statusCode, err)
+		}
+	})
+
+	t.Run("field errors", func(t *testing.T) {
+		_, _, err := client.CreateUpdateAPIRequest(ctx, http.MethodPost, "hosts/", map[string]any{"inventory": 999}, []int{201}, "")
+
+		var apiError *APIError
+		if !errors.As(err, &apiError) {
+			t.Fatalf("expected an APIError, got: %v", err)
+		}
+		if len(apiError.FieldErrors["name"]) != 1 || len(apiError.FieldErrors["inventory"]) != 1 {
+			t.Errorf("expected errors for name and inventory, got: %v", apiError.FieldErrors)
+		}
+	})
+
+	t.Run("authentication", func(t *testing.T) {
+		unauthenticated := fake.client()
+		unauthenticated.auth = "Bearer wrong"
+
+		_, statusCode, _ := unauthenticated.GenericAPIRequest(ctx, http.MethodGet, "me/", nil, []int{200}, "")
+		if statusCode != http.StatusUnauthorized {
+			t.Errorf("expected a 401, got %d", statusCode)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewCredentialListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "credential",
+		collection:  "credentials",
+		hint:        "",
+		description: "Lists the credentials on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "organization", attrType: types.Int32Type, description: "Only list credentials in the organization with this ID."},
+			{name: "credential_type", attrType: types.Int32Type, description: "Only list credentials of the credential type with this ID."},
+			searchListFilter,
+		},
+		newResource: NewCredentialResource,
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewHostListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "host",
+		collection:  "hosts",
+		hint:        "",
+		description: "Lists the hosts on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "inventory", attrType: types.Int32Type, description: "Only list hosts in the inventory with this ID."},
+			{name: "enabled", attrType: types.BoolType, description: "Only list enabled, or disabled, hosts."},
+			searchListFilter,
+		},
+		newResource: NewHostResource,
+	}
+}
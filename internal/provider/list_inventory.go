@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewInventoryListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "inventory",
+		collection:  "inventories",
+		hint:        "",
+		description: "Lists the inventories on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "organization", attrType: types.Int32Type, description: "Only list inventories in the organization with this ID."},
+			{name: "kind", attrType: types.StringType, description: "Only list inventories of this kind, i.e. `smart`. Regular inventories have an empty kind."},
+			searchListFilter,
+		},
+		newResource: NewInventoryResource,
+	}
+}
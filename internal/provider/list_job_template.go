@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewJobTemplateListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "job_template",
+		collection:  "job_templates",
+		hint:        "",
+		description: "Lists the job templates on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "organization", attrType: types.Int32Type, description: "Only list job templates in the organization with this ID."},
+			{name: "project", attrType: types.Int32Type, description: "Only list job templates that use the project with this ID."},
+			{name: "inventory", attrType: types.Int32Type, description: "Only list job templates that use the inventory with this ID."},
+			searchListFilter,
+		},
+		newResource: NewJobTemplateResource,
+	}
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func NewOrganizationListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "organization",
+		collection:  "organizations",
+		hint:        "",
+		description: "Lists the organizations on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			searchListFilter,
+		},
+		newResource: NewOrganizationResource,
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewProjectListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "project",
+		collection:  "projects",
+		hint:        "",
+		description: "Lists the projects on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "organization", attrType: types.Int32Type, description: "Only list projects in the organization with this ID."},
+			{name: "scm_type", attrType: types.StringType, description: "Only list projects of this source control type, i.e. `git`."},
+			searchListFilter,
+		},
+		newResource: NewProjectResource,
+	}
+}
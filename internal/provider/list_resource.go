@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	urlParser "net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &controllerListResource{}
+
+// An optional argument of a list block that is passed to the controller as a query parameter of the same name,
+// i.e. `organization = 1` lists `job_templates/?organization=1`. attrType is one of types.StringType,
+// types.Int32Type or types.BoolType.
+type listFilter struct {
+	name        string
+	attrType    attr.Type
+	description string
+}
+
+// Every list resource can filter by exact name and by the controller's full text search.
+var (
+	nameListFilter   = listFilter{name: "name", attrType: types.StringType, description: "Only list objects with exactly this name."}
+	searchListFilter = listFilter{name: "search", attrType: types.StringType, description: "Only list objects matching this search term, as in the search box of the UI."}
+)
+
+// Lists the objects of one controller collection for `terraform query`. Each result carries the object's
+// identity and, when Terraform asks for it, the state that the matching managed resource reads for the object,
+// which is what `-generate-config-out` turns into configuration.
+type controllerListResource struct {
+	client *providerClient
+
+	typeName    string
+	collection  string
+	hint        string
+	description string
+	filters     []listFilter
+	newResource func() resource.Resource
+}
+
+func (r *controllerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *controllerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := make(map[string]schema.Attribute, len(r.filters))
+	for _, filter := range r.filters {
+		switch filter.attrType {
+		case types.Int32Type:
+			attributes[filter.name] = schema.Int32Attribute{Optional: true, Description: filter.description}
+		case types.BoolType:
+			attributes[filter.name] = schema.BoolAttribute{Optional: true, Description: filter.description}
+		default:
+			attributes[filter.name] = schema.StringAttribute{Optional: true, Description: filter.description}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes:  attributes,
+	}
+}
+
+func (r *controllerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+func (r *controllerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	query, diags := r.query(ctx, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	url := r.collection + "/"
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+
+	body, _, err := r.client.ListAPIRequestLimit(ctx, url, req.Limit, []int{200}, r.hint)
+	if err != nil {
+		diags.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listResult := struct {
+		Results []struct {
+			Id   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"results"`
+	}{}
+	err = json.Unmarshal(body, &listResult)
+	if err != nil {
+		diags.AddError(
+			"Unable to unmarshal response body into object",
+			fmt.Sprintf("Error:  %v.", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, object := range listResult.Results {
+			result := req.NewListResult(ctx)
+			result.DisplayName = object.Name
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), types.Int64Value(object.Id))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(r.readResource(ctx, object.Id, result.Resource)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// Builds the list query from the filters set in config.
+func (r *controllerListResource) query(ctx context.Context, config tfsdk.Config) (urlParser.Values, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	query := urlParser.Values{}
+	for _, filter := range r.filters {
+		switch filter.attrType {
+		case types.Int32Type:
+			var value types.Int32
+			diags.Append(config.GetAttribute(ctx, path.Root(filter.name), &value)...)
+			if !value.IsNull() {
+				query.Set(filter.name, strconv.Itoa(int(value.ValueInt32())))
+			}
+		case types.BoolType:
+			var value types.Bool
+			diags.Append(config.GetAttribute(ctx, path.Root(filter.name), &value)...)
+			if !value.IsNull() {
+				query.Set(filter.name, strconv.FormatBool(value.ValueBool()))
+			}
+		default:
+			var value types.String
+			diags.Append(config.GetAttribute(ctx, path.Root(filter.name), &value)...)
+			if !value.IsNull() {
+				query.Set(filter.name, value.ValueString())
+			}
+		}
+	}
+
+	return query, diags
+}
+
+// Fills in the state of one listed object by running the managed resource's Read for its id, the same way a
+// refresh after `terraform import` does, so that listed and imported objects end up with the same state.
+func (r *controllerListResource) readResource(ctx context.Context, id int64, target *tfsdk.Resource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state := tfsdk.State{Schema: target.Schema, Raw: target.Raw.Copy()}
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	state, readDiags := readManagedResource(ctx, r.client, r.newResource(), state)
+	diags.Append(readDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	target.Raw = state.Raw
+	return diags
+}
+
+// Configures managed with client and runs its Read on state, which only needs to hold the attributes the
+// resource reads its object by. Returns the state the Read left behind.
+func readManagedResource(ctx context.Context, client *providerClient, managed resource.Resource, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if configurable, ok := managed.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResp)
+		diags.Append(configureResp.Diagnostics...)
+		if diags.HasError() {
+			return state, diags
+		}
+	}
+
+	readResp := &resource.ReadResponse{State: state}
+	managed.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	diags.Append(readResp.Diagnostics...)
+
+	return readResp.State, diags
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Runs a list resource against the fake controller with the given filter arguments and collects the results.
+func runListResource(t *testing.T, fake *fakeController, newListResource func() list.ListResource, filters map[string]tftypes.Value, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	listResource := newListResource().(*controllerListResource)
+	configureResp := &resource.ConfigureResponse{}
+	listResource.Configure(ctx, resource.ConfigureRequest{ProviderData: fake.client()}, configureResp)
+
+	schemaResp := &list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, schemaResp)
+
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range filters {
+		configValues[name] = value
+	}
+
+	managed := listResource.newResource()
+	resourceSchema := &resource.SchemaResponse{}
+	managed.Schema(ctx, resource.SchemaRequest{}, resourceSchema)
+	identitySchema := &resource.IdentitySchemaResponse{}
+	managed.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchema)
+
+	stream := &list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, configValues)},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("list: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func TestJobTemplateListResource(t *testing.T) {
+	fake := newFakeController(t)
+	defaultID := fake.seed("organizations", map[string]any{"name": "Default"})
+	otherID := fake.seed("organizations", map[string]any{"name": "Other"})
+	projectID := fake.seed("projects", map[string]any{"name": "playbooks", "organization": defaultID})
+	deployID := fake.seed("job_templates", map[string]any{"name": "deploy", "organization": defaultID, "project": projectID, "job_type": "run", "playbook": "site.yml"})
+	fake.seed("job_templates", map[string]any{"name": "backup", "organization": defaultID, "project": projectID, "job_type": "run", "playbook": "backup.yml"})
+	fake.seed("job_templates", map[string]any{"name": "deploy", "organization": otherID, "job_type": "run", "playbook": "site.yml"})
+
+	results := runListResource(t, fake, NewJobTemplateListResource, map[string]tftypes.Value{
+		"organization": tftypes.NewValue(tftypes.Number, defaultID),
+	}, false, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected the 2 job templates in Default, got %d", len(results))
+	}
+	if results[0].DisplayName != "deploy" || results[1].DisplayName != "backup" {
+		t.Errorf("unexpected display names: %s, %s", results[0].DisplayName, results[1].DisplayName)
+	}
+	if results[0].Resource != nil && !results[0].Resource.Raw.IsNull() {
+		t.Error("expected no resource state unless it is asked for")
+	}
+
+	results = runListResource(t, fake, NewJobTemplateListResource, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "deploy"),
+	}, true, 1)
+	if len(results) != 1 {
+		t.Fatalf("expected the limit to cut the results to 1, got %d", len(results))
+	}
+
+	var identityID types.Int64
+	if diags := results[0].Identity.GetAttribute(context.Background(), path.Root("id"), &identityID); diags.HasError() {
+		t.Fatalf("reading identity: %v", diags)
+	}
+	if identityID.ValueInt64() != deployID {
+		t.Errorf("expected the identity of job template %d, got %d", deployID, identityID.ValueInt64())
+	}
+
+	var listed JobTemplateModel
+	if diags := results[0].Resource.Get(context.Background(), &listed); diags.HasError() {
+		t.Fatalf("reading resource: %v", diags)
+	}
+	if listed.Id.ValueString() != fmt.Sprint(deployID) || listed.Playbook.ValueString() != "site.yml" || listed.Project.ValueInt32() != int32(projectID) {
+		t.Errorf("unexpected listed job template: %+v", listed)
+	}
+}
+
+func TestHostListResource_enabledFilter(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+	fake.seed("hosts", map[string]any{"name": "web1", "inventory": inventoryID, "enabled": true})
+	fake.seed("hosts", map[string]any{"name": "web2", "inventory": inventoryID, "enabled": false})
+
+	results := runListResource(t, fake, NewHostListResource, map[string]tftypes.Value{
+		"inventory": tftypes.NewValue(tftypes.Number, inventoryID),
+		"enabled":   tftypes.NewValue(tftypes.Bool, false),
+	}, false, 0)
+	if len(results) != 1 || results[0].DisplayName != "web2" {
+		t.Errorf("expected only the disabled host, got %+v", results)
+	}
+}
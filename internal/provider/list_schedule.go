@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewScheduleListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "schedule",
+		collection:  "schedules",
+		hint:        "",
+		description: "Lists the schedules on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "unified_job_template", attrType: types.Int32Type, description: "Only list schedules of the job template, workflow job template, project or inventory source with this ID."},
+			{name: "enabled", attrType: types.BoolType, description: "Only list enabled, or disabled, schedules."},
+			searchListFilter,
+		},
+		newResource: NewScheduleResource,
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewWorkflowJobTemplateListResource() list.ListResource {
+	return &controllerListResource{
+		typeName:    "workflow_job_template",
+		collection:  "workflow_job_templates",
+		hint:        "",
+		description: "Lists the workflow job templates on the automation controller, for use with `terraform query`.",
+		filters: []listFilter{
+			nameListFilter,
+			{name: "organization", attrType: types.Int32Type, description: "Only list workflow job templates in the organization with this ID."},
+			{name: "inventory", attrType: types.Int32Type, description: "Only list workflow job templates that use the inventory with this ID."},
+			searchListFilter,
+		},
+		newResource: NewWorkflowJobTemplateResource,
+	}
+}
@@ -3,17 +3,28 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/cookiejar"
+	urlParser "net/url"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,12 +32,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
 )
 
 // Ensure Provider satisfies various provider interfaces.
 var _ provider.Provider = &theProvider{}
 var _ provider.ProviderWithFunctions = &theProvider{}
+var _ provider.ProviderWithListResources = &theProvider{}
+var _ provider.ProviderWithActions = &theProvider{}
 
 // theProvider defines the provider implementation.
 type theProvider struct {
@@ -43,11 +57,38 @@ type theProviderModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	APIretry types.Object `tfsdk:"api_retry"`
+
+	AuthMethod         types.String `tfsdk:"auth_method"`
+	OAuth2ClientID     types.String `tfsdk:"oauth2_client_id"`
+	OAuth2ClientSecret types.String `tfsdk:"oauth2_client_secret"`
+	RevokeOnShutdown   types.Bool   `tfsdk:"revoke_on_shutdown"`
+
+	ConfigFile types.String `tfsdk:"config_file"`
+	Profile    types.String `tfsdk:"profile"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+
+	RequestTimeoutSeconds types.Int32  `tfsdk:"request_timeout_seconds"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	RequestHeaders        types.Map    `tfsdk:"request_headers"`
+
+	Platform types.String `tfsdk:"platform"`
+
+	MaxConcurrentRequests types.Int32   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
 }
 
 type apiRetryModel struct {
-	APIretryCount        types.Int32 `tfsdk:"api_retry_count"`
-	APIretryDelaySeconds types.Int32 `tfsdk:"api_retry_delay_seconds"`
+	APIretryCount              types.Int32 `tfsdk:"api_retry_count"`
+	APIretryDelaySeconds       types.Int32 `tfsdk:"api_retry_delay_seconds"`
+	APIretryExponentialBackoff types.Bool  `tfsdk:"api_retry_exponential_backoff"`
+	APIretryJitter             types.Bool  `tfsdk:"api_retry_jitter"`
+	APIretryMaxDelaySeconds    types.Int32 `tfsdk:"api_retry_max_delay_seconds"`
+	APIretryMutatingRequests   types.Bool  `tfsdk:"api_retry_mutating_requests"`
 }
 
 func (p *theProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -60,27 +101,112 @@ func (p *theProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 		Description: "This is a Terraform Provider for managing resources in Automation Controller such as AWX/Tower or Ansible Automation Platform (AAP).",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				Description: "URL for automation controller (i.e. https://tower.example.com)",
+				Description: "URL for automation controller (i.e. https://tower.example.com). You can also set this using the TOWER_HOST or CONTROLLER_HOST environment variables, or `host` in the config file.",
 				Optional:    true,
 			},
 			"token": schema.StringAttribute{
-				Description: "Automation controller access token (instead of username/password). You can also set this using the TOWER_OAUTH_TOKEN environment variable.",
+				Description: "Automation controller access token (instead of username/password). You can also set this using the TOWER_OAUTH_TOKEN or CONTROLLER_OAUTH_TOKEN environment variables, or `oauth_token` in the config file.",
 				Optional:    true,
 			},
 			"username": schema.StringAttribute{
-				Description: "Automation controller username (instead of token). You can also set this using the TOWER_USERNAME environment variable.",
+				Description: "Automation controller username (instead of token). You can also set this using the TOWER_USERNAME or CONTROLLER_USERNAME environment variables, or `username` in the config file.",
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Automation controller password (instead of token). You can also set this using the TOWER_PASSWORD environment variable.",
+				Description: "Automation controller password (instead of token). You can also set this using the TOWER_PASSWORD or CONTROLLER_PASSWORD environment variables, or `password` in the config file.",
+				Optional:    true,
+			},
+			"auth_method": schema.StringAttribute{
+				Description: "How `username` and `password` are used. `basic` (the default) sends them with every request. `session` logs in once through the Django login page (the platform gateway's on AAP 2.5+) and uses the session cookie, for gateways that disable HTTP Basic. `oauth2` logs in once for an access token: with the OAuth2 password grant of `oauth2_client_id` when set, otherwise by creating a personal access token. Sessions and tokens are renewed whenever the controller answers 401. You can also set this using the TOWER_AUTH_METHOD environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authMethodBasic, authMethodSession, authMethodOAuth2),
+				},
+			},
+			"oauth2_client_id": schema.StringAttribute{
+				Description: "Client ID of the OAuth2 application used for the password grant when `auth_method` is `oauth2`. You can also set this using the TOWER_OAUTH2_CLIENT_ID environment variable.",
+				Optional:    true,
+			},
+			"oauth2_client_secret": schema.StringAttribute{
+				Description: "Client secret of `oauth2_client_id`, for confidential applications. You can also set this using the TOWER_OAUTH2_CLIENT_SECRET environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"revoke_on_shutdown": schema.BoolAttribute{
+				Description: "When `auth_method` is `session` or `oauth2`, log out or revoke the token the provider created when the provider process exits, and revoke a token as soon as logging in again after a 401 response replaces it. Defaults to true. You can also set this using the TOWER_REVOKE_ON_SHUTDOWN environment variable.",
+				Optional:    true,
+			},
+			"config_file": schema.StringAttribute{
+				Description: "Path to a tower-cli / ansible.controller style INI config file to read `host`, `oauth_token`, `username`, `password` and `verify_ssl` from. Settings in the provider block and environment variables take precedence over the file. Defaults to ~/.tower_cli.cfg, which is skipped when it doesn't exist. You can also set this using the TOWER_CONFIG_FILE environment variable.",
+				Optional:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "The section of `config_file` to read settings from, allowing one file to hold several controllers. Defaults to `general`, the section used by tower-cli. You can also set this using the TOWER_PROFILE environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded CA certificate bundle used to verify the automation controller's TLS certificate, in addition to the system trust store. You can also set this using the TOWER_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificate bundle used to verify the automation controller's TLS certificate, in addition to the system trust store. Use instead of `ca_cert_file` when the bundle isn't available on disk. You can also set this using the TOWER_CA_CERT_PEM environment variable.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the automation controller's TLS certificate. Only intended for test environments. You can also set this using the TOWER_INSECURE_SKIP_VERIFY environment variable, the CONTROLLER_VERIFY_SSL environment variable (with the opposite meaning), or `verify_ssl` in the config file.",
+				Optional:    true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded client certificate presented to the automation controller, for controllers fronted by mutual TLS. Must be set together with `client_key_file`. You can also set this using the TOWER_CLIENT_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key for `client_cert_file`. You can also set this using the TOWER_CLIENT_KEY_FILE environment variable.",
+				Optional:    true,
+			},
+			"request_timeout_seconds": schema.Int32Attribute{
+				Description: "The number of seconds to wait for a single API request to complete, including reading the response. Defaults to 30. You can also set this using the TOWER_REQUEST_TIMEOUT_SECONDS environment variable.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used for all API requests (i.e. http://proxy.example.com:3128). When set, it takes precedence over the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables, which are otherwise honoured. You can also set this using the TOWER_PROXY_URL environment variable.",
+				Optional:    true,
+			},
+			"request_headers": schema.MapAttribute{
+				Description: "A map of static HTTP headers added to every API request, such as a tenant or correlation header required by an API gateway in front of the automation controller. The `Authorization`, `Content-Type`, `Cookie` and `X-CSRFToken` headers are set by the provider for authentication and can't be set here.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"platform": schema.StringAttribute{
+				Description: "The kind of automation platform at `endpoint`: `awx` for AWX and AAP 2.4 or earlier, where the controller API is at /api/v2/, or `aap` for AAP 2.5 or later, where the controller API is at /api/controller/v2/ and organizations, teams and users are managed through the platform gateway. Defaults to `auto`, which detects the platform by probing /api/, /api/gateway/v1/ and /api/v2/ping/ when the provider is configured, and fails if none of them identifies it. You can also set this using the TOWER_PLATFORM environment variable.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformAuto, platformAWX, platformAAP),
+				},
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Description: "The maximum number of API requests this provider will have in flight at once, regardless of Terraform's -parallelism. Unlimited when not set. You can also set this using the TOWER_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "The maximum rate at which this provider starts API requests. Fractional values are allowed, i.e. 0.5 for one request every two seconds. Unlimited when not set. You can also set this using the TOWER_REQUESTS_PER_SECOND environment variable.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
 			},
 			"api_retry": schema.SingleNestedAttribute{
-				Description: "An optional block to define if the provider should retry GET/read API requests that intitially fail.",
+				Description: "An optional block to define if the provider should retry API requests that intitially fail. GET/read requests are retried on any unexpected response. A `Retry-After` header sent with a 429 or 503 response is honoured when it asks for a longer wait than the configured delay.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"api_retry_count": schema.Int32Attribute{
-						Description: "The number of times a GET/read API request should be reattempted should it not succeed on the first try. Can be useful when the number of Terraform objects in your plan creates many API calls and causes the AWX/AAP platform to bog down. Valid values are integers between 1 and 5. You can also set this using the TOWER_API_RETRY_COUNT environment variable.",
+						Description: "The number of times an API request should be reattempted should it not succeed on the first try. Can be useful when the number of Terraform objects in your plan creates many API calls and causes the AWX/AAP platform to bog down. Valid values are integers between 1 and 5. You can also set this using the TOWER_API_RETRY_COUNT environment variable.",
 						Required:    true,
 						Validators: []validator.Int32{
 							int32validator.Between(1, 5),
@@ -93,6 +219,25 @@ func (p *theProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 							int32validator.AtLeast(1),
 						},
 					},
+					"api_retry_exponential_backoff": schema.BoolAttribute{
+						Description: "When true, the delay between attempts doubles after each retry, starting at `api_retry_delay_seconds`. Defaults to false. You can also set this using the TOWER_API_RETRY_EXPONENTIAL_BACKOFF environment variable.",
+						Optional:    true,
+					},
+					"api_retry_jitter": schema.BoolAttribute{
+						Description: "When true, each delay is randomized to between half and all of its computed value so that many parallel requests don't retry at the same moment. Defaults to false. You can also set this using the TOWER_API_RETRY_JITTER environment variable.",
+						Optional:    true,
+					},
+					"api_retry_max_delay_seconds": schema.Int32Attribute{
+						Description: "The upper bound, in seconds, for the delay between attempts when `api_retry_exponential_backoff` is enabled. The value must be an integer value of 1 or greater. You can also set this using the TOWER_API_RETRY_MAX_DELAY_SECONDS environment variable.",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"api_retry_mutating_requests": schema.BoolAttribute{
+						Description: "When true, POST/PUT/PATCH/DELETE requests are also retried, but only on responses and errors which mean the controller never processed the request: 429, 502, 503 and 504 responses, and refused or reset connections. Defaults to false. You can also set this using the TOWER_API_RETRY_MUTATING_REQUESTS environment variable.",
+						Optional:    true,
+					},
 				},
 			},
 		},
@@ -113,6 +258,14 @@ func (p *theProvider) ConfigValidators(ctx context.Context) []provider.ConfigVal
 			path.MatchRoot("username"),
 			path.MatchRoot("password"),
 		),
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_file"),
+			path.MatchRoot("ca_cert_pem"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_cert_file"),
+			path.MatchRoot("client_key_file"),
+		),
 	}
 }
 
@@ -129,17 +282,29 @@ func (p *theProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	configProfile, err := loadConfigFileProfile(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			fmt.Sprintf("Unable to load the config file profile: %s.", err.Error()))
+		return
+	}
+
+	// Each setting is taken from the first of: the provider block, the TOWER_* environment variables, the
+	// CONTROLLER_* environment variables and finally the config file profile.
 	if !data.Endpoint.IsNull() {
 		endpoint = data.Endpoint.ValueString()
+	} else if envEndpoint, ok := lookupFirstEnv("TOWER_HOST", "CONTROLLER_HOST"); ok {
+		endpoint = envEndpoint
 	} else {
-		endpoint = os.Getenv("TOWER_HOST")
+		endpoint = configProfile.Host
 	}
 
 	if endpoint == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Endpoint Configuration",
 			"While configuring the provider, the API endpoint hostname was not found in "+
-				"the TOWER_HOST environment variable or provider "+
+				"the TOWER_HOST or CONTROLLER_HOST environment variables, the config file or the provider "+
 				"configuration block endpoint attribute.",
 		)
 		// Not returning early allows the logic to collect all errors.
@@ -152,18 +317,22 @@ func (p *theProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		}
 	}
 
-	envToken, tokenExists := os.LookupEnv("TOWER_OAUTH_TOKEN")
-	envUsername, userExists := os.LookupEnv("TOWER_USERNAME")
-	envPassword, passwordExists := os.LookupEnv("TOWER_PASSWORD")
+	envToken, envUsername, envPassword, envCredentials := lookupEnvCredentials()
 
-	// Get token if password/username not set
-	if data.Token.IsNull() && data.Username.IsNull() && data.Password.IsNull() && tokenExists {
-		token = envToken
-	}
+	credentialsInBlock := !data.Token.IsNull() || !data.Username.IsNull() || !data.Password.IsNull()
 
-	if data.Token.IsNull() && data.Username.IsNull() && data.Password.IsNull() && !tokenExists && userExists && passwordExists {
+	// credentials are never mixed between sources; a token wins over a username/password from the same source
+	switch {
+	case credentialsInBlock:
+	case envCredentials:
+		token = envToken
 		username = envUsername
 		password = envPassword
+	case configProfile.OAuthToken != "":
+		token = configProfile.OAuthToken
+	default:
+		username = configProfile.Username
+		password = configProfile.Password
 	}
 
 	if !data.Token.IsNull() {
@@ -181,84 +350,257 @@ func (p *theProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	if (token != "" && (username != "" || password != "")) || (token == "" && (username == "" || password == "")) {
 		resp.Diagnostics.AddError(
 			"Provider Configuration Error",
-			"Specify a token (TOWER_OAUTH_TOKEN) OR username/password (TOWER_USERNAME/TOWER_PASSWORD).")
+			"Specify a token (TOWER_OAUTH_TOKEN) OR username/password (TOWER_USERNAME/TOWER_PASSWORD), in the provider block, the environment or the config file.")
 		return
 	}
 
-	if token != "" {
+	authMethod := os.Getenv("TOWER_AUTH_METHOD")
+	if !data.AuthMethod.IsNull() {
+		authMethod = data.AuthMethod.ValueString()
+	}
+
+	var login *loginConfig
+
+	switch authMethod {
+	case "", authMethodBasic:
+	case authMethodSession, authMethodOAuth2:
+		if token != "" {
+			resp.Diagnostics.AddError(
+				"Provider Configuration Error",
+				fmt.Sprintf("auth_method %q logs in with a username/password, but a token was configured.", authMethod))
+			return
+		}
+
+		login = &loginConfig{
+			method:             authMethod,
+			username:           username,
+			password:           password,
+			oauth2ClientID:     os.Getenv("TOWER_OAUTH2_CLIENT_ID"),
+			oauth2ClientSecret: os.Getenv("TOWER_OAUTH2_CLIENT_SECRET"),
+			revokeOnShutdown:   true,
+		}
+
+		if !data.OAuth2ClientID.IsNull() {
+			login.oauth2ClientID = data.OAuth2ClientID.ValueString()
+		}
+		if !data.OAuth2ClientSecret.IsNull() {
+			login.oauth2ClientSecret = data.OAuth2ClientSecret.ValueString()
+		}
+
+		if !data.RevokeOnShutdown.IsNull() {
+			login.revokeOnShutdown = data.RevokeOnShutdown.ValueBool()
+		} else if envRevoke, ok := os.LookupEnv("TOWER_REVOKE_ON_SHUTDOWN"); ok {
+			revoke, err := strconv.ParseBool(envRevoke)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Provider Configuration Error",
+					fmt.Sprintf("TOWER_REVOKE_ON_SHUTDOWN must be a boolean, got: %s", envRevoke),
+				)
+				return
+			}
+			login.revokeOnShutdown = revoke
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			fmt.Sprintf("TOWER_AUTH_METHOD must be one of %q, %q or %q, got: %s", authMethodBasic, authMethodSession, authMethodOAuth2, authMethod),
+		)
+		return
+	}
+
+	switch {
+	case login != nil:
+		// set by the first login
+	case token != "":
 		auth = "Bearer" + " " + token
-	} else {
+	default:
 		authString := username + ":" + password
 		encodedAuth := base64.StdEncoding.EncodeToString([]byte(authString))
 		auth = "Basic" + " " + encodedAuth
 	}
 
-	httpclient := &http.Client{
-		Timeout: 30 * time.Second,
+	tlsConfig, err := buildTLSConfig(data, configProfile)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			fmt.Sprintf("Unable to configure TLS: %s.", err.Error()))
+		return
 	}
 
-	client := new(providerClient)
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
 
-	client.client = httpclient
-	client.endpoint = endpoint
-	client.auth = auth
-
-	if configprefix.Prefix == "awx" {
-		client.urlPrefix = "/api/v2/"
+	proxyURL := os.Getenv("TOWER_PROXY_URL")
+	if !data.ProxyURL.IsNull() {
+		proxyURL = data.ProxyURL.ValueString()
 	}
 
-	if configprefix.Prefix == "aap" {
-		client.urlPrefix = "/api/controller/v2/"
+	if proxyURL != "" {
+		parsedProxyURL, err := urlParser.Parse(proxyURL)
+		if err != nil || parsedProxyURL.Scheme == "" || parsedProxyURL.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Provider Configuration Error",
+				fmt.Sprintf("proxy_url must be an absolute URL such as http://proxy.example.com:3128, got: %s", proxyURL),
+			)
+			return
+		}
+		transport.Proxy = http.ProxyURL(parsedProxyURL)
 	}
 
-	if data.APIretry.IsNull() {
-		envAPIRRetryCount, envAPIRetryCountExists := os.LookupEnv("TOWER_API_RETRY_COUNT")
-		envAPIRetryDelaySeconds, envAPIRetryDelaySecondsExists := os.LookupEnv("TOWER_API_RETRY_DELAY_SECONDS")
+	requestTimeout := 30 * time.Second
 
-		if envAPIRetryCountExists != envAPIRetryDelaySecondsExists {
+	if !data.RequestTimeoutSeconds.IsNull() {
+		requestTimeout = time.Duration(data.RequestTimeoutSeconds.ValueInt32()) * time.Second
+	} else if envTimeout, ok := os.LookupEnv("TOWER_REQUEST_TIMEOUT_SECONDS"); ok {
+		timeoutInt, err := strconv.Atoi(envTimeout)
+		if err != nil || timeoutInt < 1 {
 			resp.Diagnostics.AddError(
 				"Provider Configuration Error",
-				"Both TOWER_API_RETRY_COUNT and TOWER_API_RETRY_DELAY_SECONDS environment variables must be set together.",
+				fmt.Sprintf("TOWER_REQUEST_TIMEOUT_SECONDS must be an integer of 1 or greater, got: %s", envTimeout),
 			)
 			return
-		} else if envAPIRetryCountExists && envAPIRetryDelaySecondsExists {
-			retryCountInt, err := strconv.Atoi(envAPIRRetryCount)
-			if err != nil {
+		}
+		requestTimeout = time.Duration(timeoutInt) * time.Second
+	}
+
+	httpclient := &http.Client{
+		Timeout:   requestTimeout,
+		Transport: transport,
+	}
+
+	if login != nil && login.method == authMethodSession {
+		// the jar only holds this run's session, it's never persisted
+		httpclient.Jar, _ = cookiejar.New(nil)
+	}
+
+	client := new(providerClient)
+
+	client.client = httpclient
+	client.endpoint = endpoint
+	client.auth = auth
+	client.login = login
+
+	maxConcurrentRequests := data.MaxConcurrentRequests.ValueInt32()
+	if data.MaxConcurrentRequests.IsNull() {
+		if envMaxConcurrent, ok := os.LookupEnv("TOWER_MAX_CONCURRENT_REQUESTS"); ok {
+			maxConcurrentInt, err := strconv.Atoi(envMaxConcurrent)
+			if err != nil || maxConcurrentInt < 1 {
 				resp.Diagnostics.AddError(
 					"Provider Configuration Error",
-					fmt.Sprintf("TOWER_API_RETRY_COUNT must be an integer, got: %s", envAPIRRetryCount),
+					fmt.Sprintf("TOWER_MAX_CONCURRENT_REQUESTS must be an integer of 1 or greater, got: %s", envMaxConcurrent),
 				)
 				return
 			}
-			retryDelayInt, err := strconv.Atoi(envAPIRetryDelaySeconds)
-			if err != nil {
+			maxConcurrentRequests = int32(maxConcurrentInt)
+		}
+	}
+
+	requestsPerSecond := data.RequestsPerSecond.ValueFloat64()
+	if data.RequestsPerSecond.IsNull() {
+		if envRequestsPerSecond, ok := os.LookupEnv("TOWER_REQUESTS_PER_SECOND"); ok {
+			requestsPerSecondFloat, err := strconv.ParseFloat(envRequestsPerSecond, 64)
+			if err != nil || requestsPerSecondFloat < 0.1 {
 				resp.Diagnostics.AddError(
 					"Provider Configuration Error",
-					fmt.Sprintf("TOWER_API_RETRY_DELAY_SECONDS must be an integer, got: %s", envAPIRetryDelaySeconds),
+					fmt.Sprintf("TOWER_REQUESTS_PER_SECOND must be a number of 0.1 or greater, got: %s", envRequestsPerSecond),
 				)
 				return
 			}
-			client.apiRetryCount = int32(retryCountInt)
-			client.apiRetryDelaySeconds = int32(retryDelayInt)
+			requestsPerSecond = requestsPerSecondFloat
 		}
 	}
 
-	if !data.APIretry.IsNull() {
-		var retryBlock apiRetryModel
+	client.limiter = newRequestLimiter(maxConcurrentRequests, requestsPerSecond)
+
+	if !data.RequestHeaders.IsNull() {
+		resp.Diagnostics.Append(data.RequestHeaders.ElementsAs(ctx, &client.headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
+		for name := range client.headers {
+			if slices.ContainsFunc(reservedRequestHeaders, func(reserved string) bool { return strings.EqualFold(name, reserved) }) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("request_headers"),
+					"Provider Configuration Error",
+					fmt.Sprintf("The %s header is set by the provider and can't be set in request_headers.", name),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var retryBlock apiRetryModel
+
+	if !data.APIretry.IsNull() {
 		resp.Diagnostics.Append(data.APIretry.As(ctx, &retryBlock, basetypes.ObjectAsOptions{})...)
 
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
 
-		client.apiRetryCount = retryBlock.APIretryCount.ValueInt32()
-		client.apiRetryDelaySeconds = retryBlock.APIretryDelaySeconds.ValueInt32()
+	if err := client.configureAPIRetry(retryBlock, !data.APIretry.IsNull()); err != nil {
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			err.Error(),
+		)
+		return
+	}
+
+	platform := os.Getenv("TOWER_PLATFORM")
+	if !data.Platform.IsNull() {
+		platform = data.Platform.ValueString()
+	}
+
+	switch platform {
+	case platformAWX, platformAAP:
+	case "", platformAuto:
+		detectedPlatform, err := client.detectPlatform(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("platform"),
+				"Unable to detect automation platform",
+				fmt.Sprintf("Set the platform attribute or TOWER_PLATFORM environment variable to choose explicitly. Error was: %s.", err.Error()))
+			return
+		}
+		platform = detectedPlatform
+	default:
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			fmt.Sprintf("TOWER_PLATFORM must be one of %q, %q or %q, got: %s", platformAuto, platformAWX, platformAAP, platform),
+		)
+		return
+	}
+
+	client.setPlatform(platform)
+
+	tflog.Debug(ctx, "Configured automation platform", map[string]any{
+		"platform":   platform,
+		"url_prefix": client.urlPrefix,
+	})
+
+	if client.login != nil {
+		if err := client.authenticate(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"tower authentication failure",
+				fmt.Sprintf("Unable to log in with auth_method %q. Error was: %s.", client.login.method, err.Error()))
+			return
+		}
+
+		if client.login.revokeOnShutdown {
+			shutdownClients.Lock()
+			shutdownClients.clients = append(shutdownClients.clients, client)
+			shutdownClients.Unlock()
+		}
 	}
 
 	url := "me/"
 
-	_, _, err := client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	_, _, err = client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"tower authentication failure",
@@ -266,8 +608,173 @@ func (p *theProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	// a failed lookup leaves every version dependent capability enabled, so it is logged rather than reported
+	if err := client.discoverController(ctx); err != nil {
+		tflog.Warn(ctx, "Unable to discover automation controller version", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	tflog.Debug(ctx, "Discovered automation controller", map[string]any{
+		"version":      client.controller.Version,
+		"license_type": client.controller.LicenseType,
+		"capabilities": client.controller.capabilities,
+	})
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
+}
+
+// Build the TLS settings for the transport used by providerClient. Each setting falls back to its TOWER_*
+// environment variable when it isn't set in the provider block. Certificate verification additionally falls
+// back to CONTROLLER_VERIFY_SSL and then verify_ssl in the config file profile.
+func buildTLSConfig(data theProviderModel, configProfile configFileProfile) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	var caPEM []byte
+
+	caCertFile := os.Getenv("TOWER_CA_CERT_FILE")
+	if !data.CACertFile.IsNull() {
+		caCertFile = data.CACertFile.ValueString()
+	}
+
+	caCertPEM := os.Getenv("TOWER_CA_CERT_PEM")
+	if !data.CACertPEM.IsNull() {
+		caCertPEM = data.CACertPEM.ValueString()
+	}
+
+	if caCertFile != "" && caCertPEM != "" {
+		return nil, errors.New("a CA certificate file and a CA certificate PEM can't both be set")
+	}
+
+	if caCertFile != "" {
+		fileData, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate file %s: %v", caCertFile, err)
+		}
+		caPEM = fileData
+	} else if caCertPEM != "" {
+		caPEM = []byte(caCertPEM)
+	}
+
+	if caPEM != nil {
+		certPool, err := x509.SystemCertPool()
+		if err != nil || certPool == nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no PEM encoded certificates found in the CA certificate bundle")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if !data.InsecureSkipVerify.IsNull() {
+		tlsConfig.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if envInsecure, ok := os.LookupEnv("TOWER_INSECURE_SKIP_VERIFY"); ok {
+		insecure, err := strconv.ParseBool(envInsecure)
+		if err != nil {
+			return nil, fmt.Errorf("TOWER_INSECURE_SKIP_VERIFY must be a boolean, got: %s", envInsecure)
+		}
+		tlsConfig.InsecureSkipVerify = insecure
+	} else if envVerify, ok := os.LookupEnv("CONTROLLER_VERIFY_SSL"); ok {
+		verify, err := strconv.ParseBool(envVerify)
+		if err != nil {
+			return nil, fmt.Errorf("CONTROLLER_VERIFY_SSL must be a boolean, got: %s", envVerify)
+		}
+		tlsConfig.InsecureSkipVerify = !verify
+	} else if configProfile.VerifySSL != nil {
+		tlsConfig.InsecureSkipVerify = !*configProfile.VerifySSL
+	}
+
+	clientCertFile := os.Getenv("TOWER_CLIENT_CERT_FILE")
+	if !data.ClientCertFile.IsNull() {
+		clientCertFile = data.ClientCertFile.ValueString()
+	}
+
+	clientKeyFile := os.Getenv("TOWER_CLIENT_KEY_FILE")
+	if !data.ClientKeyFile.IsNull() {
+		clientKeyFile = data.ClientKeyFile.ValueString()
+	}
+
+	if (clientCertFile == "") != (clientKeyFile == "") {
+		return nil, errors.New("a client certificate and client key must be set together")
+	}
+
+	if clientCertFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate/key pair: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+// Sets the retry settings of client from the api_retry block. The count and delay are required in the block, so
+// they fall back to TOWER_API_RETRY_COUNT and TOWER_API_RETRY_DELAY_SECONDS together, and only without a block.
+// The optional settings each fall back to their TOWER_API_RETRY_* environment variable when the block doesn't set
+// them.
+func (c *providerClient) configureAPIRetry(retryBlock apiRetryModel, blockSet bool) error {
+	if blockSet {
+		c.apiRetryCount = retryBlock.APIretryCount.ValueInt32()
+		c.apiRetryDelaySeconds = retryBlock.APIretryDelaySeconds.ValueInt32()
+	} else {
+		envAPIRRetryCount, envAPIRetryCountExists := os.LookupEnv("TOWER_API_RETRY_COUNT")
+		envAPIRetryDelaySeconds, envAPIRetryDelaySecondsExists := os.LookupEnv("TOWER_API_RETRY_DELAY_SECONDS")
+
+		if envAPIRetryCountExists != envAPIRetryDelaySecondsExists {
+			return errors.New("Both TOWER_API_RETRY_COUNT and TOWER_API_RETRY_DELAY_SECONDS environment variables must be set together.")
+		} else if envAPIRetryCountExists && envAPIRetryDelaySecondsExists {
+			retryCountInt, err := strconv.Atoi(envAPIRRetryCount)
+			if err != nil {
+				return fmt.Errorf("TOWER_API_RETRY_COUNT must be an integer, got: %s", envAPIRRetryCount)
+			}
+			retryDelayInt, err := strconv.Atoi(envAPIRetryDelaySeconds)
+			if err != nil {
+				return fmt.Errorf("TOWER_API_RETRY_DELAY_SECONDS must be an integer, got: %s", envAPIRetryDelaySeconds)
+			}
+			c.apiRetryCount = int32(retryCountInt)
+			c.apiRetryDelaySeconds = int32(retryDelayInt)
+		}
+	}
+
+	boolSettings := []struct {
+		envName string
+		value   types.Bool
+		target  *bool
+	}{
+		{"TOWER_API_RETRY_EXPONENTIAL_BACKOFF", retryBlock.APIretryExponentialBackoff, &c.apiRetryExponentialBackoff},
+		{"TOWER_API_RETRY_JITTER", retryBlock.APIretryJitter, &c.apiRetryJitter},
+		{"TOWER_API_RETRY_MUTATING_REQUESTS", retryBlock.APIretryMutatingRequests, &c.apiRetryMutatingRequests},
+	}
+	for _, setting := range boolSettings {
+		if !setting.value.IsNull() {
+			*setting.target = setting.value.ValueBool()
+		} else if envValue, ok := os.LookupEnv(setting.envName); ok {
+			parsed, err := strconv.ParseBool(envValue)
+			if err != nil {
+				return fmt.Errorf("%s must be a boolean, got: %s", setting.envName, envValue)
+			}
+			*setting.target = parsed
+		}
+	}
+
+	if !retryBlock.APIretryMaxDelaySeconds.IsNull() {
+		c.apiRetryMaxDelaySeconds = retryBlock.APIretryMaxDelaySeconds.ValueInt32()
+	} else if envMaxDelay, ok := os.LookupEnv("TOWER_API_RETRY_MAX_DELAY_SECONDS"); ok {
+		maxDelayInt, err := strconv.Atoi(envMaxDelay)
+		if err != nil || maxDelayInt < 1 {
+			return fmt.Errorf("TOWER_API_RETRY_MAX_DELAY_SECONDS must be an integer of 1 or greater, got: %s", envMaxDelay)
+		}
+		c.apiRetryMaxDelaySeconds = int32(maxDelayInt)
+	}
+
+	return nil
 }
 
 func (p *theProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -282,6 +789,7 @@ func (p *theProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewInstanceGroupResource,
 		NewInventoryResource,
 		NewInventorySourceResource,
+		NewJobLaunchResource,
 		NewJobTemplateCredentialResource,
 		NewJobTemplateInstanceGroupsResource,
 		NewJobTemplateLabelsResource,
@@ -300,6 +808,7 @@ func (p *theProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewScheduleResource,
 		NewTeamResource,
 		NewUserResource,
+		NewWorkflowJobLaunchResource,
 		NewWorkflowJobTemplateApprovalNodeResource,
 		NewWorkflowJobTemplateJobNodeCredentialResource,
 		NewWorkflowJobTemplateJobNodeResource,
@@ -337,6 +846,27 @@ func (p *theProvider) DataSources(ctx context.Context) []func() datasource.DataS
 	}
 }
 
+func (p *theProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewCredentialListResource,
+		NewHostListResource,
+		NewInventoryListResource,
+		NewJobTemplateListResource,
+		NewOrganizationListResource,
+		NewProjectListResource,
+		NewScheduleListResource,
+		NewWorkflowJobTemplateListResource,
+	}
+}
+
+func (p *theProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewInventorySourceUpdateAction,
+		NewJobTemplateLaunchAction,
+		NewProjectUpdateAction,
+	}
+}
+
 func (p *theProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		//NewExampleFunction,
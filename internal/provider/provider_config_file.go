@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The profile read from a config file when none is chosen. tower-cli and the ansible.controller collection
+// both keep their settings in a [general] section, or in no section at all.
+const defaultConfigProfile = "general"
+
+// The config file read when neither config_file nor TOWER_CONFIG_FILE is set, relative to the home directory.
+const defaultConfigFileName = ".tower_cli.cfg"
+
+// The settings of one profile in a tower-cli / ansible.controller style config file, i.e.
+//
+//	[general]
+//	host = https://tower.example.com
+//	oauth_token = abc123
+//	verify_ssl = false
+//
+// Only the keys below are used; anything else in the file is ignored.
+type configFileProfile struct {
+	Host       string
+	OAuthToken string
+	Username   string
+	Password   string
+	VerifySSL  *bool
+}
+
+// Loads the profile selected by the provider block or the TOWER_CONFIG_FILE and TOWER_PROFILE environment
+// variables. Returns an empty profile when no config file was asked for and ~/.tower_cli.cfg doesn't exist.
+func loadConfigFileProfile(data theProviderModel) (configFileProfile, error) {
+	configFile := os.Getenv("TOWER_CONFIG_FILE")
+	if !data.ConfigFile.IsNull() {
+		configFile = data.ConfigFile.ValueString()
+	}
+
+	profile := os.Getenv("TOWER_PROFILE")
+	if !data.Profile.IsNull() {
+		profile = data.Profile.ValueString()
+	}
+
+	explicitFile := configFile != ""
+	if !explicitFile {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			if profile != "" {
+				return configFileProfile{}, fmt.Errorf("profile %q was set but no config file was given and the home directory is unknown: %v", profile, err)
+			}
+			return configFileProfile{}, nil
+		}
+		configFile = filepath.Join(home, defaultConfigFileName)
+	} else if rest, ok := strings.CutPrefix(configFile, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return configFileProfile{}, fmt.Errorf("unable to expand %s: %v", configFile, err)
+		}
+		configFile = filepath.Join(home, rest)
+	}
+
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		if !explicitFile && profile == "" && errors.Is(err, fs.ErrNotExist) {
+			return configFileProfile{}, nil
+		}
+		return configFileProfile{}, fmt.Errorf("unable to read config file %s: %v", configFile, err)
+	}
+
+	sections := parseConfigFile(string(content))
+
+	sectionName := profile
+	if sectionName == "" {
+		sectionName = defaultConfigProfile
+	}
+
+	section, ok := sections[sectionName]
+	if !ok {
+		if profile != "" {
+			return configFileProfile{}, fmt.Errorf("profile %q not found in config file %s", profile, configFile)
+		}
+		return configFileProfile{}, nil
+	}
+
+	loaded := configFileProfile{
+		Host:       section["host"],
+		OAuthToken: section["oauth_token"],
+		Username:   section["username"],
+		Password:   section["password"],
+	}
+
+	if verifySSL, ok := section["verify_ssl"]; ok {
+		verify, err := strconv.ParseBool(verifySSL)
+		if err != nil {
+			return configFileProfile{}, fmt.Errorf("verify_ssl in profile %q of config file %s must be a boolean, got: %s", sectionName, configFile, verifySSL)
+		}
+		loaded.VerifySSL = &verify
+	}
+
+	return loaded, nil
+}
+
+// Parses an INI style config file into its sections. Keys before the first section header belong to the
+// [general] section, which is how tower-cli writes its file. Both "key = value" and "key: value" are accepted,
+// along with # and ; comments. Values may be wrapped in quotes.
+func parseConfigFile(content string) map[string]map[string]string {
+	sections := map[string]map[string]string{}
+	current := defaultConfigProfile
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[current]; !ok {
+				sections[current] = map[string]string{}
+			}
+			continue
+		}
+
+		separator := strings.IndexAny(line, "=:")
+		if separator < 0 {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(line[:separator]))
+		value := strings.TrimSpace(line[separator+1:])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		if _, ok := sections[current]; !ok {
+			sections[current] = map[string]string{}
+		}
+		sections[current][key] = value
+	}
+
+	return sections
+}
+
+// Returns the value of the first of names that is set in the environment, so that the TOWER_* variables can
+// take precedence over their CONTROLLER_* equivalents.
+func lookupFirstEnv(names ...string) (string, bool) {
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// Returns the token or username/password pair set in the environment. The TOWER_* variables are read first
+// and the CONTROLLER_* ones only when the TOWER_* ones hold no complete credentials, so credentials are never
+// mixed between the two families and a CONTROLLER_* token never overrides a TOWER_* username/password.
+func lookupEnvCredentials() (token, username, password string, ok bool) {
+	for _, prefix := range []string{"TOWER_", "CONTROLLER_"} {
+		if token, ok := os.LookupEnv(prefix + "OAUTH_TOKEN"); ok {
+			return token, "", "", true
+		}
+
+		username, userExists := os.LookupEnv(prefix + "USERNAME")
+		password, passwordExists := os.LookupEnv(prefix + "PASSWORD")
+		if userExists && passwordExists {
+			return "", username, password, true
+		}
+	}
+
+	return "", "", "", false
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testConfigFile = `# written by tower-cli
+host = https://tower.example.com
+username: admin
+password = "s3cr=t:pass"
+verify_ssl = false
+
+[staging]
+host = https://staging.example.com
+oauth_token = abc123
+; verify_ssl defaults to true
+`
+
+func writeTestConfigFile(t *testing.T) string {
+	t.Helper()
+
+	configFile := filepath.Join(t.TempDir(), "tower_cli.cfg")
+	if err := os.WriteFile(configFile, []byte(testConfigFile), 0o600); err != nil {
+		t.Fatalf("unable to write config file: %v", err)
+	}
+
+	return configFile
+}
+
+func TestLoadConfigFileProfile(t *testing.T) {
+	configFile := writeTestConfigFile(t)
+
+	t.Setenv("TOWER_CONFIG_FILE", "")
+	t.Setenv("TOWER_PROFILE", "")
+
+	general, err := loadConfigFileProfile(theProviderModel{
+		ConfigFile: types.StringValue(configFile),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if general.Host != "https://tower.example.com" || general.Username != "admin" || general.Password != "s3cr=t:pass" {
+		t.Errorf("unexpected general profile: %+v", general)
+	}
+	if general.VerifySSL == nil || *general.VerifySSL {
+		t.Errorf("expected verify_ssl to be false, got: %v", general.VerifySSL)
+	}
+
+	t.Setenv("TOWER_PROFILE", "staging")
+
+	staging, err := loadConfigFileProfile(theProviderModel{
+		ConfigFile: types.StringValue(configFile),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if staging.Host != "https://staging.example.com" || staging.OAuthToken != "abc123" || staging.Username != "" {
+		t.Errorf("unexpected staging profile: %+v", staging)
+	}
+	if staging.VerifySSL != nil {
+		t.Errorf("expected verify_ssl to be unset, got: %v", *staging.VerifySSL)
+	}
+}
+
+func TestLoadConfigFileProfile_missing(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TOWER_CONFIG_FILE", "")
+	t.Setenv("TOWER_PROFILE", "")
+
+	// a missing ~/.tower_cli.cfg is only an error when a profile was asked for
+	if _, err := loadConfigFileProfile(theProviderModel{}); err != nil {
+		t.Fatalf("unexpected error without a default config file: %v", err)
+	}
+
+	if _, err := loadConfigFileProfile(theProviderModel{Profile: types.StringValue("staging")}); err == nil {
+		t.Fatal("expected an error for a profile without a config file")
+	}
+
+	if _, err := loadConfigFileProfile(theProviderModel{ConfigFile: types.StringValue(filepath.Join(t.TempDir(), "missing.cfg"))}); err == nil {
+		t.Fatal("expected an error for a missing config file")
+	}
+
+	configFile := writeTestConfigFile(t)
+	if _, err := loadConfigFileProfile(theProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("production"),
+	}); err == nil {
+		t.Fatal("expected an error for an unknown profile")
+	}
+}
+
+func TestBuildTLSConfig_verifySSLPrecedence(t *testing.T) {
+	verify := true
+
+	t.Setenv("TOWER_INSECURE_SKIP_VERIFY", "")
+	os.Unsetenv("TOWER_INSECURE_SKIP_VERIFY")
+	t.Setenv("CONTROLLER_VERIFY_SSL", "false")
+
+	tlsConfig, err := buildTLSConfig(theProviderModel{}, configFileProfile{VerifySSL: &verify})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tlsConfig.InsecureSkipVerify {
+		t.Error("expected CONTROLLER_VERIFY_SSL to take precedence over the config file")
+	}
+
+	tlsConfig, err = buildTLSConfig(theProviderModel{InsecureSkipVerify: types.BoolValue(false)}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig.InsecureSkipVerify {
+		t.Error("expected insecure_skip_verify to take precedence over CONTROLLER_VERIFY_SSL")
+	}
+}
+
+func TestLookupEnvCredentials(t *testing.T) {
+	for _, name := range []string{"TOWER_OAUTH_TOKEN", "TOWER_USERNAME", "TOWER_PASSWORD", "CONTROLLER_OAUTH_TOKEN", "CONTROLLER_USERNAME", "CONTROLLER_PASSWORD"} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+
+	if _, _, _, ok := lookupEnvCredentials(); ok {
+		t.Fatal("expected no credentials in an empty environment")
+	}
+
+	t.Setenv("TOWER_USERNAME", "tower-user")
+	t.Setenv("TOWER_PASSWORD", "tower-pass")
+	t.Setenv("CONTROLLER_OAUTH_TOKEN", "controller-token")
+
+	token, username, password, ok := lookupEnvCredentials()
+	if !ok || token != "" || username != "tower-user" || password != "tower-pass" {
+		t.Errorf("expected the TOWER_* username/password to win over CONTROLLER_OAUTH_TOKEN, got token %q, username %q, password %q", token, username, password)
+	}
+
+	os.Unsetenv("TOWER_PASSWORD")
+	t.Setenv("CONTROLLER_USERNAME", "controller-user")
+	t.Setenv("CONTROLLER_PASSWORD", "controller-pass")
+
+	token, username, password, ok = lookupEnvCredentials()
+	if !ok || token != "controller-token" || username != "" || password != "" {
+		t.Errorf("expected an incomplete TOWER_* pair to fall back to CONTROLLER_OAUTH_TOKEN, got token %q, username %q, password %q", token, username, password)
+	}
+
+	t.Setenv("TOWER_OAUTH_TOKEN", "tower-token")
+
+	token, _, _, _ = lookupEnvCredentials()
+	if token != "tower-token" {
+		t.Errorf("expected TOWER_OAUTH_TOKEN to win, got %q", token)
+	}
+}
@@ -2,11 +2,29 @@
 package provider
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
 )
 
@@ -22,3 +40,256 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("TOWER_OAUTH_TOKEN must be set for acceptance tests")
 	}
 }
+
+func TestBuildTLSConfig_caCertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tlsConfig, err := buildTLSConfig(theProviderModel{
+		CACertPEM: types.StringValue(string(caPEM)),
+	}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	httpResp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the custom CA to be trusted, got: %v", err)
+	}
+	httpResp.Body.Close()
+}
+
+func TestBuildTLSConfig_invalidCACert(t *testing.T) {
+	_, err := buildTLSConfig(theProviderModel{
+		CACertPEM: types.StringValue("not a certificate"),
+	}, configFileProfile{})
+	if err == nil {
+		t.Fatal("expected an error for a CA bundle without certificates")
+	}
+}
+
+// GETs url with a transport using tlsConfig.
+func getWithTLSConfig(url string, tlsConfig *tls.Config) error {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	httpResp, err := (&http.Client{Transport: transport}).Get(url)
+	if err != nil {
+		return err
+	}
+	return httpResp.Body.Close()
+}
+
+func TestBuildTLSConfig_envCACertConflict(t *testing.T) {
+	t.Setenv("TOWER_CA_CERT_FILE", "/etc/ssl/controller.pem")
+	t.Setenv("TOWER_CA_CERT_PEM", "-----BEGIN CERTIFICATE-----")
+
+	_, err := buildTLSConfig(theProviderModel{}, configFileProfile{})
+	if err == nil {
+		t.Fatal("expected an error when both TOWER_CA_CERT_FILE and TOWER_CA_CERT_PEM are set")
+	}
+}
+
+func TestBuildTLSConfig_insecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tlsConfig, err := buildTLSConfig(theProviderModel{}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := getWithTLSConfig(server.URL, tlsConfig); err == nil {
+		t.Fatal("expected the self-signed certificate to be rejected by default")
+	}
+
+	t.Setenv("TOWER_INSECURE_SKIP_VERIFY", "true")
+
+	tlsConfig, err = buildTLSConfig(theProviderModel{}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := getWithTLSConfig(server.URL, tlsConfig); err != nil {
+		t.Fatalf("expected certificate verification to be skipped, got: %v", err)
+	}
+
+	tlsConfig, err = buildTLSConfig(theProviderModel{InsecureSkipVerify: types.BoolValue(false)}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := getWithTLSConfig(server.URL, tlsConfig); err == nil {
+		t.Fatal("expected insecure_skip_verify = false to override TOWER_INSECURE_SKIP_VERIFY")
+	}
+}
+
+func TestBuildTLSConfig_clientCertificate(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test client CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	clientCertFile := filepath.Join(dir, "client.pem")
+	clientKeyFile := filepath.Join(dir, "client-key.pem")
+	if err := os.WriteFile(clientCertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(clientKeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: clientKeyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	var clientName string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientName = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caPEM := types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})))
+
+	tlsConfig, err := buildTLSConfig(theProviderModel{CACertPEM: caPEM}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := getWithTLSConfig(server.URL, tlsConfig); err == nil {
+		t.Fatal("expected the server to reject a connection without a client certificate")
+	}
+
+	tlsConfig, err = buildTLSConfig(theProviderModel{
+		CACertPEM:      caPEM,
+		ClientCertFile: types.StringValue(clientCertFile),
+		ClientKeyFile:  types.StringValue(clientKeyFile),
+	}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := getWithTLSConfig(server.URL, tlsConfig); err != nil {
+		t.Fatalf("expected the client certificate to be accepted, got: %v", err)
+	}
+	if clientName != "terraform" {
+		t.Errorf("expected the server to see client certificate %q, got %q", "terraform", clientName)
+	}
+
+	t.Setenv("TOWER_CLIENT_CERT_FILE", clientCertFile)
+	t.Setenv("TOWER_CLIENT_KEY_FILE", clientKeyFile)
+
+	tlsConfig, err = buildTLSConfig(theProviderModel{CACertPEM: caPEM}, configFileProfile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := getWithTLSConfig(server.URL, tlsConfig); err != nil {
+		t.Fatalf("expected the client certificate from the environment to be accepted, got: %v", err)
+	}
+}
+
+func TestConfigureAPIRetry_environment(t *testing.T) {
+	t.Setenv("TOWER_API_RETRY_COUNT", "3")
+	t.Setenv("TOWER_API_RETRY_DELAY_SECONDS", "2")
+	t.Setenv("TOWER_API_RETRY_EXPONENTIAL_BACKOFF", "true")
+	t.Setenv("TOWER_API_RETRY_JITTER", "true")
+	t.Setenv("TOWER_API_RETRY_MAX_DELAY_SECONDS", "30")
+	t.Setenv("TOWER_API_RETRY_MUTATING_REQUESTS", "true")
+
+	client := &providerClient{}
+	if err := client.configureAPIRetry(apiRetryModel{}, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.apiRetryCount != 3 || client.apiRetryDelaySeconds != 2 || !client.apiRetryExponentialBackoff || !client.apiRetryJitter || client.apiRetryMaxDelaySeconds != 30 || !client.apiRetryMutatingRequests {
+		t.Errorf("expected every retry setting from the environment, got %+v", client)
+	}
+
+	// the block's settings win, and the environment fills in the ones it leaves out
+	client = &providerClient{}
+	err := client.configureAPIRetry(apiRetryModel{
+		APIretryCount:              types.Int32Value(1),
+		APIretryDelaySeconds:       types.Int32Value(5),
+		APIretryExponentialBackoff: types.BoolValue(false),
+	}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.apiRetryCount != 1 || client.apiRetryDelaySeconds != 5 || client.apiRetryExponentialBackoff || !client.apiRetryJitter || client.apiRetryMaxDelaySeconds != 30 {
+		t.Errorf("expected the api_retry block to take precedence, got %+v", client)
+	}
+
+	t.Setenv("TOWER_API_RETRY_JITTER", "sometimes")
+	if err := (&providerClient{}).configureAPIRetry(apiRetryModel{}, false); err == nil {
+		t.Error("expected an error for a TOWER_API_RETRY_JITTER that isn't a boolean")
+	}
+}
+
+func TestConfigure_reservedRequestHeaders(t *testing.T) {
+	ctx := t.Context()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"X-Tenant":      types.StringValue("ops"),
+		"authorization": types.StringValue("Bearer someone-elses-token"),
+	})
+	data := theProviderModel{
+		Endpoint:       types.StringValue("https://controller.example.com"),
+		Token:          types.StringValue("token"),
+		APIretry:       types.ObjectNull(schemaResp.Schema.Attributes["api_retry"].GetType().(types.ObjectType).AttrTypes),
+		RequestHeaders: headers,
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "authorization") {
+		t.Errorf("expected the Authorization header to be rejected, got %v", resp.Diagnostics)
+	}
+}
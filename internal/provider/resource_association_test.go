@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Creates an association resource with two children, then removes the first one, which must be disassociated
+// on the controller rather than associated again.
+func testAssociationRemoval[M any](t *testing.T, newResource func() resource.Resource, parentCollection, subCollection string, model func(parentID string, childIDs []attr.Value) M) {
+	t.Helper()
+
+	fake := newFakeController(t)
+	target := subCollection
+	if mapped, ok := fakeAssociationTargets[subCollection]; ok {
+		target = mapped
+	}
+
+	parentID := fake.seed(parentCollection, map[string]any{"name": "parent"})
+	removedID := fake.seed(target, map[string]any{"name": "removed"})
+	keptID := fake.seed(target, map[string]any{"name": "kept"})
+
+	h := newResourceHarness[M](t, newResource, fake.client())
+
+	created := h.create(model(fmt.Sprint(parentID), []attr.Value{types.Int32Value(int32(removedID)), types.Int32Value(int32(keptID))}))
+	if associated := fake.associated(parentCollection, parentID, subCollection); len(associated) != 2 {
+		t.Fatalf("expected both children to be associated, got: %v", associated)
+	}
+
+	h.update(created, model(fmt.Sprint(parentID), []attr.Value{types.Int32Value(int32(keptID))}))
+	if associated := fake.associated(parentCollection, parentID, subCollection); !slices.Equal(associated, []int64{keptID}) {
+		t.Errorf("expected only child %d to be left associated, got: %v", keptID, associated)
+	}
+}
+
+func TestAssociationResources_removeChildren(t *testing.T) {
+	t.Run("job_template_instance_group", func(t *testing.T) {
+		testAssociationRemoval(t, NewJobTemplateInstanceGroupsResource, "job_templates", "instance_groups", func(parentID string, childIDs []attr.Value) JobTemplateInstanceGroupsResourceModel {
+			return JobTemplateInstanceGroupsResourceModel{JobTemplateId: types.StringValue(parentID), InstanceGroupsIDs: types.ListValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("job_template_label", func(t *testing.T) {
+		testAssociationRemoval(t, NewJobTemplateLabelsResource, "job_templates", "labels", func(parentID string, childIDs []attr.Value) JobTemplateLabelsResourceModel {
+			return JobTemplateLabelsResourceModel{JobTemplateId: types.StringValue(parentID), LabelIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("job_template_notification_template_error", func(t *testing.T) {
+		testAssociationRemoval(t, NewJobTemplateNotifTemplErrResource, "job_templates", "notification_templates_error", func(parentID string, childIDs []attr.Value) JobTemplateNotifTemplErrResourceModel {
+			return JobTemplateNotifTemplErrResourceModel{JobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("job_template_notification_template_started", func(t *testing.T) {
+		testAssociationRemoval(t, NewJobTemplateNotifTemplStartedResource, "job_templates", "notification_templates_started", func(parentID string, childIDs []attr.Value) JobTemplateNotifTemplStartedResourceModel {
+			return JobTemplateNotifTemplStartedResourceModel{JobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("job_template_notification_template_success", func(t *testing.T) {
+		testAssociationRemoval(t, NewJobTemplateNotifTemplSuccessResource, "job_templates", "notification_templates_success", func(parentID string, childIDs []attr.Value) JobTemplateNotifTemplSuccessResourceModel {
+			return JobTemplateNotifTemplSuccessResourceModel{JobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_notification_template_approvals", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNotifTemplApprovalsResource, "workflow_job_templates", "notification_templates_approvals", func(parentID string, childIDs []attr.Value) WorkflowJobTemplateNotifTemplApprovalsResourceModel {
+			return WorkflowJobTemplateNotifTemplApprovalsResourceModel{WorkflowJobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_notification_template_error", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNotifTemplErrorResource, "workflow_job_templates", "notification_templates_error", func(parentID string, childIDs []attr.Value) WorkflowJobTemplateNotifTemplErrorResourceModel {
+			return WorkflowJobTemplateNotifTemplErrorResourceModel{WorkflowJobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_notification_template_started", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNotifTemplStartedResource, "workflow_job_templates", "notification_templates_started", func(parentID string, childIDs []attr.Value) WorkflowJobTemplateNotifTemplStartedResourceModel {
+			return WorkflowJobTemplateNotifTemplStartedResourceModel{WorkflowJobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_notification_template_success", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNotifTemplSuccessResource, "workflow_job_templates", "notification_templates_success", func(parentID string, childIDs []attr.Value) WorkflowJobTemplateNotifTemplSuccessResourceModel {
+			return WorkflowJobTemplateNotifTemplSuccessResourceModel{WorkflowJobTemplateId: types.StringValue(parentID), NotifTEmplateIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_job_node_credential", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateJobNodeCredentialResource, "workflow_job_template_nodes", "credentials", func(parentID string, childIDs []attr.Value) WorkflowJobTemplateJobNodeCredentialResourceModel {
+			return WorkflowJobTemplateJobNodeCredentialResourceModel{Id: types.StringValue(parentID), CredentialIds: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_node_label", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNodeLabelResource, "workflow_job_template_nodes", "labels", func(parentID string, childIDs []attr.Value) WorkflowJobTemplatesNodeLabelResourceModel {
+			return WorkflowJobTemplatesNodeLabelResourceModel{Id: types.StringValue(parentID), LabelIDs: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_node_success", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNodeSuccessResource, "workflow_job_template_nodes", "success_nodes", func(parentID string, childIDs []attr.Value) WorkflowJobTemplatesNodeSuccessResourceModel {
+			return WorkflowJobTemplatesNodeSuccessResourceModel{Id: types.StringValue(parentID), SuccessIds: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_node_failure", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNodeFailureResource, "workflow_job_template_nodes", "failure_nodes", func(parentID string, childIDs []attr.Value) WorkflowJobTemplatesNodeFailureResourceModel {
+			return WorkflowJobTemplatesNodeFailureResourceModel{Id: types.StringValue(parentID), FailureIds: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+	t.Run("workflow_job_template_node_always", func(t *testing.T) {
+		testAssociationRemoval(t, NewWorkflowJobTemplateNodeAlwaysResource, "workflow_job_template_nodes", "always_nodes", func(parentID string, childIDs []attr.Value) WorkflowJobTemplatesNodeAlwaysResourceModel {
+			return WorkflowJobTemplatesNodeAlwaysResourceModel{Id: types.StringValue(parentID), AlwaysIds: types.SetValueMust(types.Int32Type, childIDs)}
+		})
+	})
+}
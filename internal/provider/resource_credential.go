@@ -21,6 +21,10 @@ import (
 
 var _ resource.Resource = &CredentialResource{}
 var _ resource.ResourceWithImportState = &CredentialResource{}
+var _ resource.ResourceWithIdentity = &CredentialResource{}
+var _ resource.ResourceWithMoveState = &CredentialResource{}
+
+var credentialAPIFieldPaths = apiFieldPaths(CredentialModel{})
 
 func NewCredentialResource() resource.Resource {
 	return &CredentialResource{}
@@ -164,6 +168,14 @@ The only changes to the inputs field that will be sent are when the terraform co
 	}
 }
 
+func (r *CredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *CredentialResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyCredentialResource.stateMovers()
+}
+
 func (r CredentialResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.ExactlyOneOf(
@@ -258,9 +270,7 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 	url := "credentials/"
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API http request", err, credentialAPIFieldPaths)
 		return
 	}
 
@@ -278,6 +288,7 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 	data.Kind = types.StringValue(fmt.Sprintf("%v", returnedData["kind"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -288,6 +299,11 @@ func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -508,9 +524,7 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	url := fmt.Sprintf("credentials/%d/", id)
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API update request", err, credentialAPIFieldPaths)
 		return
 	}
 
@@ -527,6 +541,7 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	data.Kind = types.StringValue(fmt.Sprintf("%v", returnedData["kind"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -556,6 +571,10 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
 
 	idUnescaped, _ := strconv.Unquote(`"` + req.ID + `"`)
 
@@ -564,12 +583,11 @@ func (r *CredentialResource) ImportState(ctx context.Context, req resource.Impor
 
 	switch {
 	case countParts == 1:
-		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, credentialImportKey)
 
 	case ((countParts >= 3) && ((countParts-1)%2) == 0): // verify they provided pairs of values beyond the ID
 
-		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+		importStateByNaturalKey(ctx, r.client, path.Root("id"), resource.ImportStateRequest{ID: idParts[0]}, resp, credentialImportKey)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -595,7 +613,7 @@ func (r *CredentialResource) ImportState(ctx context.Context, req resource.Impor
 		}
 
 	default:
-		resp.Diagnostics.AddError("Invalid import id string", "The import string at the end must contain one int id value or that plus comma-separated pairs for string keys with corresponding secrets.")
+		resp.Diagnostics.AddError("Invalid import id string", "The import string at the end must contain one id value (numeric, or organization/credential-type/name) or that plus comma-separated pairs for string keys with corresponding secrets.")
 
 	}
 
@@ -17,6 +17,7 @@ import (
 
 var _ resource.Resource = &CredentialInputSourcesResource{}
 var _ resource.ResourceWithImportState = &CredentialInputSourcesResource{}
+var _ resource.ResourceWithIdentity = &CredentialInputSourcesResource{}
 
 func NewCredentialInputSourcesResource() resource.Resource {
 	return &CredentialInputSourcesResource{}
@@ -66,6 +67,10 @@ func (r *CredentialInputSourcesResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *CredentialInputSourcesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *CredentialInputSourcesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -133,6 +138,7 @@ func (r *CredentialInputSourcesResource) Create(ctx context.Context, req resourc
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CredentialInputSourcesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -143,6 +149,11 @@ func (r *CredentialInputSourcesResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -235,6 +246,7 @@ func (r *CredentialInputSourcesResource) Update(ctx context.Context, req resourc
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -266,5 +278,10 @@ func (r *CredentialInputSourcesResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *CredentialInputSourcesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
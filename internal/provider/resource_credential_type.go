@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &CredentialTypeResource{}
 var _ resource.ResourceWithImportState = &CredentialTypeResource{}
+var _ resource.ResourceWithIdentity = &CredentialTypeResource{}
 
 func NewCredentialTypeResource() resource.Resource {
 	return &CredentialTypeResource{}
@@ -74,6 +75,10 @@ func (r *CredentialTypeResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *CredentialTypeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *CredentialTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -159,6 +164,7 @@ func (r *CredentialTypeResource) Create(ctx context.Context, req resource.Create
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CredentialTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -170,6 +176,11 @@ func (r *CredentialTypeResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -344,6 +355,7 @@ func (r *CredentialTypeResource) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CredentialTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -374,5 +386,10 @@ func (r *CredentialTypeResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *CredentialTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, nameImportKey("credential_types", ""))
 }
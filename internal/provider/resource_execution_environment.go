@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &ExecutionEnvironmentResource{}
 var _ resource.ResourceWithImportState = &ExecutionEnvironmentResource{}
+var _ resource.ResourceWithIdentity = &ExecutionEnvironmentResource{}
 
 func NewExecutionEnvironmentResource() resource.Resource {
 	return &ExecutionEnvironmentResource{}
@@ -74,6 +75,10 @@ func (r *ExecutionEnvironmentResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *ExecutionEnvironmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *ExecutionEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -140,6 +145,7 @@ func (r *ExecutionEnvironmentResource) Create(ctx context.Context, req resource.
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ExecutionEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -150,6 +156,11 @@ func (r *ExecutionEnvironmentResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -258,6 +269,7 @@ func (r *ExecutionEnvironmentResource) Update(ctx context.Context, req resource.
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ExecutionEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -287,5 +299,10 @@ func (r *ExecutionEnvironmentResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *ExecutionEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, nameImportKey("execution_environments", ""))
 }
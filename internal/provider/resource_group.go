@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,10 @@ import (
 
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithIdentity = &GroupResource{}
+var _ resource.ResourceWithConfigValidators = &GroupResource{}
+
+var groupAPIFieldPaths = apiFieldPaths(GroupModel{})
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -58,13 +63,31 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"variables": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Group variables in JSON or YAML format. Recommend using Terraform jsonencode() function to give this attribute a value.",
 				Optional:    true,
 			},
+			"variables_map": schema.DynamicAttribute{
+				Description: "Variables as an object, i.e. `{ ansible_host = \"10.0.0.1\" }`, instead of the JSON or YAML string of `variables`. Conflicts with `variables`.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+func (r *GroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *GroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("variables"),
+			path.MatchRoot("variables_map"),
+		),
+	}
+}
+
 func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -102,13 +125,19 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !(data.Variables.IsNull()) {
 		bodyData.Variables = data.Variables.ValueString()
 	}
+	if !data.VariablesMap.IsNull() {
+		variables, diags := variablesMapToString(data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.Variables = variables
+	}
 
 	url := "groups/"
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API http request", err, withVariablesMapFieldPath(groupAPIFieldPaths, "variables", "variables_map", data.VariablesMap))
 		return
 	}
 
@@ -125,6 +154,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -135,6 +165,11 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -177,7 +212,18 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
-	if !data.Variables.IsNull() || responseData.Variables != "" {
+	if !data.VariablesMap.IsNull() {
+		variablesMap, diags := variablesMapFromAPI(data.VariablesMap, responseData.Variables)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables_map"), variablesMap)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if !data.Variables.IsNull() || responseData.Variables != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables"), responseData.Variables)...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -212,17 +258,24 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !(data.Variables.IsNull()) {
 		bodyData.Variables = data.Variables.ValueString()
 	}
+	if !data.VariablesMap.IsNull() {
+		variables, diags := variablesMapToString(data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.Variables = variables
+	}
 
 	url := fmt.Sprintf("groups/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API update request", err, withVariablesMapFieldPath(groupAPIFieldPaths, "variables", "variables_map", data.VariablesMap))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -252,5 +305,10 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, inventoryScopedImportKey("groups"))
 }
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,8 +16,8 @@ import (
 )
 
 var _ resource.Resource = &GroupHostResource{}
-
-// var _ resource.ResourceWithImportState = &GroupHostResource{}
+var _ resource.ResourceWithImportState = &GroupHostResource{}
+var _ resource.ResourceWithIdentity = &GroupHostResource{}
 
 func NewGroupHostResource() resource.Resource {
 	return &GroupHostResource{}
@@ -51,6 +53,10 @@ func (r *GroupHostResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *GroupHostResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = groupHostIdentity.schema()
+}
+
 func (r *GroupHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -102,6 +108,7 @@ func (r *GroupHostResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(groupHostIdentity.set(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -113,6 +120,11 @@ func (r *GroupHostResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(groupHostIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	hostId, err := strconv.Atoi(data.HostId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to convert host id to int in read.", fmt.Sprintf("Unable to convert %v to int", data.HostId.ValueString()))
@@ -126,7 +138,7 @@ func (r *GroupHostResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	url := fmt.Sprintf("groups/%d/hosts/?id=%d", groupId, hostId)
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -178,6 +190,7 @@ func (r *GroupHostResource) Update(ctx context.Context, req resource.UpdateReque
 	// because we have this resource scheme setup to require replace, the update method is intentially bare-minimum
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(groupHostIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *GroupHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -215,16 +228,64 @@ func (r *GroupHostResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
-// see https://developer.hashicorp.com/terraform/plugin/framework/resources/import
-// and
-// https://developer.hashicorp.com/terraform/plugin/framework/resources/identity#importing-by-identity
-// if we end up needing to add support for import to this resource type.
-//
-// Also, be sure to uncomment out the line at the top of this file related to Import if you implmeent import.
-//
-// This is not like imports we've done in this provider for other resources as there is no unique ID in the
-// the system to designate this resources. Instead, it's a combo of the groupID & the HostID.
-
-// func (r *GroupHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-// 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-// }
+// There is no id for a group membership, so the import id is the pair `group_id/host_id`. The membership is
+// looked up before it is imported so that importing a host that isn't in the group fails instead of planning
+// to create it.
+func (r *GroupHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		groupHostIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import id string",
+			fmt.Sprintf("The import id must be of the form group_id/host_id, got: %q.", req.ID))
+		return
+	}
+
+	groupId, err := strconv.Atoi(idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("unable to convert group id to int in import.", fmt.Sprintf("Unable to convert %v to int", idParts[0]))
+		return
+	}
+
+	hostId, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("unable to convert host id to int in import.", fmt.Sprintf("Unable to convert %v to int", idParts[1]))
+		return
+	}
+
+	url := fmt.Sprintf("groups/%d/hosts/?id=%d", groupId, hostId)
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	result := struct {
+		Count int `json:"count"`
+	}{}
+	if statusCode == 200 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
+			return
+		}
+	}
+
+	if result.Count != 1 {
+		resp.Diagnostics.AddError(
+			"Group host not found",
+			fmt.Sprintf("Host %d is not a member of group %d.", hostId, groupId))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_id"), idParts[1])...)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -70,6 +71,13 @@ func TestAccGroupHostResource(t *testing.T) {
 					),
 				},
 			},
+			{
+				ResourceName:                         fmt.Sprintf("%s_group_host.grp-host-link", configprefix.Prefix),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    importStateGroupHostID(fmt.Sprintf("%s_group_host.grp-host-link", configprefix.Prefix)),
+				ImportStateVerifyIdentifierAttribute: "group_id",
+			},
 			{
 				Config: testAccGrpHstOrgInv() + testAccGrpHst1stPass() + testAccGrpHst2ndPassGrp2(),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -163,3 +171,40 @@ resource "%[1]s_group_host" "grp2-host-link" {
 }	
 	`, configprefix.Prefix, acctest.RandString(5))
 }
+
+func TestGroupHostResource_offline(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+	groupID := fake.seed("groups", map[string]any{"name": "web", "inventory": inventoryID})
+	hostID := fake.seed("hosts", map[string]any{"name": "web1", "inventory": inventoryID})
+	otherHostID := fake.seed("hosts", map[string]any{"name": "web2", "inventory": inventoryID})
+
+	h := newResourceHarness[GroupHostModel](t, NewGroupHostResource, fake.client())
+
+	created := h.create(GroupHostModel{
+		GroupId: types.StringValue(fmt.Sprint(groupID)),
+		HostId:  types.StringValue(fmt.Sprint(hostID)),
+	})
+
+	imported := h.importState(fmt.Sprintf("%d/%d", groupID, hostID))
+	if imported != created {
+		t.Errorf("expected the imported membership to match state, got %+v, want %+v", imported, created)
+	}
+
+	for _, importID := range []string{
+		fmt.Sprintf("%d/%d", groupID, otherHostID),
+		fmt.Sprintf("%d/%d", hostID, hostID),
+		fmt.Sprint(groupID),
+		fmt.Sprintf("web/%d", hostID),
+	} {
+		if _, diags := h.tryImportState(importID); !diags.HasError() {
+			t.Errorf("expected importing %s to fail", importID)
+		}
+	}
+
+	h.delete(created)
+	if _, exists := h.read(created); exists {
+		t.Error("expected a deleted membership to be removed from state")
+	}
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Skips a resource.UnitTest when no Terraform CLI is available, as the offline tests against the fake controller
+// must not depend on downloading one.
+func testUnitPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform CLI not found on PATH; set TF_ACC_TERRAFORM_PATH to run this test")
+	}
+}
+
+// Drives the CRUD and import methods of a resource directly, the way the framework would during an apply, so a
+// resource can be tested against the fake controller without the Terraform CLI. T is the resource's tfsdk model.
+type resourceHarness[T any] struct {
+	t        *testing.T
+	ctx      context.Context
+	resource resource.Resource
+	schema   resource.SchemaResponse
+
+	// The identity schema of a resource.ResourceWithIdentity, and the identity returned by the last create, read
+	// or update.
+	identitySchema *resource.IdentitySchemaResponse
+	identity       *tfsdk.ResourceIdentity
+}
+
+func newResourceHarness[T any](t *testing.T, newResource func() resource.Resource, client *providerClient) *resourceHarness[T] {
+	t.Helper()
+
+	h := &resourceHarness[T]{
+		t:        t,
+		ctx:      context.Background(),
+		resource: newResource(),
+	}
+
+	h.resource.Schema(h.ctx, resource.SchemaRequest{}, &h.schema)
+	if h.schema.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", h.schema.Diagnostics)
+	}
+
+	if identifiable, ok := h.resource.(resource.ResourceWithIdentity); ok {
+		h.identitySchema = &resource.IdentitySchemaResponse{}
+		identifiable.IdentitySchema(h.ctx, resource.IdentitySchemaRequest{}, h.identitySchema)
+		if h.identitySchema.Diagnostics.HasError() {
+			t.Fatalf("identity schema: %v", h.identitySchema.Diagnostics)
+		}
+	}
+
+	if configurable, ok := h.resource.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		configurable.Configure(h.ctx, resource.ConfigureRequest{ProviderData: client}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("configure: %v", configureResp.Diagnostics)
+		}
+	}
+
+	return h
+}
+
+func (h *resourceHarness[T]) nullState() tfsdk.State {
+	return tfsdk.State{
+		Schema: h.schema.Schema,
+		Raw:    tftypes.NewValue(h.schema.Schema.Type().TerraformType(h.ctx), nil),
+	}
+}
+
+// Returns an empty identity for the resource to fill in, or nil when the resource has no identity.
+func (h *resourceHarness[T]) nullIdentity() *tfsdk.ResourceIdentity {
+	if h.identitySchema == nil {
+		return nil
+	}
+
+	return &tfsdk.ResourceIdentity{
+		Schema: h.identitySchema.IdentitySchema,
+		Raw:    tftypes.NewValue(h.identitySchema.IdentitySchema.Type().TerraformType(h.ctx), nil),
+	}
+}
+
+func (h *resourceHarness[T]) plan(model T) tfsdk.Plan {
+	h.t.Helper()
+
+	plan := tfsdk.Plan{Schema: h.schema.Schema, Raw: tftypes.NewValue(h.schema.Schema.Type().TerraformType(h.ctx), nil)}
+	if diags := plan.Set(h.ctx, &model); diags.HasError() {
+		h.t.Fatalf("building plan: %v", diags)
+	}
+
+	return plan
+}
+
+func (h *resourceHarness[T]) state(model T) tfsdk.State {
+	h.t.Helper()
+
+	state := h.nullState()
+	if diags := state.Set(h.ctx, &model); diags.HasError() {
+		h.t.Fatalf("building state: %v", diags)
+	}
+
+	return state
+}
+
+func (h *resourceHarness[T]) model(state tfsdk.State) T {
+	h.t.Helper()
+
+	var model T
+	if diags := state.Get(h.ctx, &model); diags.HasError() {
+		h.t.Fatalf("reading state: %v", diags)
+	}
+
+	return model
+}
+
+func (h *resourceHarness[T]) create(planned T) T {
+	h.t.Helper()
+
+	created, diags := h.tryCreate(planned)
+	if diags.HasError() {
+		h.t.Fatalf("create: %v", diags)
+	}
+
+	return created
+}
+
+// Like create, but returns the diagnostics instead of failing the test, for tests of rejected plans. The plan
+// is used as the config too, which holds as long as the model has no unknown values besides computed attributes.
+func (h *resourceHarness[T]) tryCreate(planned T) (T, diag.Diagnostics) {
+	h.t.Helper()
+
+	plan := h.plan(planned)
+	resp := &resource.CreateResponse{State: h.nullState(), Identity: h.nullIdentity()}
+	h.resource.Create(h.ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		var notCreated T
+		return notCreated, resp.Diagnostics
+	}
+	h.identity = resp.Identity
+
+	return h.model(resp.State), resp.Diagnostics
+}
+
+// Returns the refreshed model, and false when the resource removed itself from state.
+func (h *resourceHarness[T]) read(current T) (T, bool) {
+	h.t.Helper()
+
+	resp := &resource.ReadResponse{State: h.state(current), Identity: h.nullIdentity()}
+	h.resource.Read(h.ctx, resource.ReadRequest{State: h.state(current)}, resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	h.identity = resp.Identity
+
+	if resp.State.Raw.IsNull() {
+		var removed T
+		return removed, false
+	}
+
+	return h.model(resp.State), true
+}
+
+func (h *resourceHarness[T]) update(current, planned T) T {
+	h.t.Helper()
+
+	plan := h.plan(planned)
+	resp := &resource.UpdateResponse{State: h.state(current), Identity: h.nullIdentity()}
+	h.resource.Update(h.ctx, resource.UpdateRequest{Plan: plan, Config: tfsdk.Config(plan), State: h.state(current)}, resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("update: %v", resp.Diagnostics)
+	}
+	h.identity = resp.Identity
+
+	return h.model(resp.State)
+}
+
+// Runs the resource's ModifyPlan for an update from current to planned, and returns the plan it leaves.
+func (h *resourceHarness[T]) modifyPlan(current, planned T) T {
+	h.t.Helper()
+
+	modifier, ok := h.resource.(resource.ResourceWithModifyPlan)
+	if !ok {
+		h.t.Fatal("the resource doesn't modify plans")
+	}
+
+	plan := h.plan(planned)
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	modifier.ModifyPlan(h.ctx, resource.ModifyPlanRequest{Plan: plan, Config: tfsdk.Config(plan), State: h.state(current)}, resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("modify plan: %v", resp.Diagnostics)
+	}
+
+	var model T
+	if diags := resp.Plan.Get(h.ctx, &model); diags.HasError() {
+		h.t.Fatalf("reading plan: %v", diags)
+	}
+
+	return model
+}
+
+func (h *resourceHarness[T]) delete(current T) {
+	h.t.Helper()
+
+	if diags := h.tryDelete(current); diags.HasError() {
+		h.t.Fatalf("delete: %v", diags)
+	}
+}
+
+// Like delete, but returns the diagnostics instead of failing the test, for tests of refused deletes.
+func (h *resourceHarness[T]) tryDelete(current T) diag.Diagnostics {
+	h.t.Helper()
+
+	resp := &resource.DeleteResponse{State: h.state(current)}
+	h.resource.Delete(h.ctx, resource.DeleteRequest{State: h.state(current)}, resp)
+
+	return resp.Diagnostics
+}
+
+// Imports id and refreshes the result, as `terraform import` does.
+func (h *resourceHarness[T]) importState(id string) T {
+	h.t.Helper()
+
+	imported, diags := h.tryImportState(id)
+	if diags.HasError() {
+		h.t.Fatalf("import: %v", diags)
+	}
+
+	return imported
+}
+
+// Imports by identity, as an `import { identity = {...} }` block does, and refreshes the result.
+func (h *resourceHarness[T]) importIdentity(identity map[string]int64) T {
+	h.t.Helper()
+
+	importIdentity := h.nullIdentity()
+	if importIdentity == nil {
+		h.t.Fatal("resource does not implement identity")
+	}
+	for name, id := range identity {
+		if diags := importIdentity.SetAttribute(h.ctx, path.Root(name), id); diags.HasError() {
+			h.t.Fatalf("building identity: %v", diags)
+		}
+	}
+
+	imported, diags := h.tryImport(resource.ImportStateRequest{Identity: importIdentity})
+	if diags.HasError() {
+		h.t.Fatalf("import: %v", diags)
+	}
+
+	return imported
+}
+
+// Like importState, but returns the diagnostics instead of failing the test, for tests of rejected import ids.
+func (h *resourceHarness[T]) tryImportState(id string) (T, diag.Diagnostics) {
+	h.t.Helper()
+
+	return h.tryImport(resource.ImportStateRequest{ID: id})
+}
+
+func (h *resourceHarness[T]) tryImport(req resource.ImportStateRequest) (T, diag.Diagnostics) {
+	h.t.Helper()
+
+	importer, ok := h.resource.(resource.ResourceWithImportState)
+	if !ok {
+		h.t.Fatal("resource does not implement import")
+	}
+
+	resp := &resource.ImportStateResponse{State: h.nullState(), Identity: req.Identity}
+	if resp.Identity == nil {
+		resp.Identity = h.nullIdentity()
+	}
+	importer.ImportState(h.ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		var notImported T
+		return notImported, resp.Diagnostics
+	}
+
+	imported, ok := h.read(h.model(resp.State))
+	if !ok {
+		h.t.Fatalf("import: %s was not found", req.ID)
+	}
+
+	return imported, resp.Diagnostics
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +21,11 @@ import (
 
 var _ resource.Resource = &HostResource{}
 var _ resource.ResourceWithImportState = &HostResource{}
+var _ resource.ResourceWithIdentity = &HostResource{}
+var _ resource.ResourceWithConfigValidators = &HostResource{}
+var _ resource.ResourceWithMoveState = &HostResource{}
+
+var hostAPIFieldPaths = apiFieldPaths(HostModel{})
 
 func NewHostResource() resource.Resource {
 	return &HostResource{}
@@ -66,15 +72,37 @@ func (r *HostResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"variables": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Specify `vars` for the template. Default value is `\"---\"`",
 				Optional:    true,
 				Default:     stringdefault.StaticString("---"),
 				Computed:    true,
 			},
+			"variables_map": schema.DynamicAttribute{
+				Description: "Variables as an object, i.e. `{ ansible_host = \"10.0.0.1\" }`, instead of the JSON or YAML string of `variables`. Conflicts with `variables`.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+func (r *HostResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *HostResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyHostResource.stateMovers()
+}
+
+func (r *HostResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("variables"),
+			path.MatchRoot("variables_map"),
+		),
+	}
+}
+
 func (r *HostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -113,13 +141,19 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if !(data.Variables.IsNull()) {
 		bodyData.Variables = data.Variables.ValueString()
 	}
+	if !data.VariablesMap.IsNull() {
+		variables, diags := variablesMapToString(data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.Variables = variables
+	}
 
 	url := "hosts/"
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API http request", err, withVariablesMapFieldPath(hostAPIFieldPaths, "variables", "variables_map", data.VariablesMap))
 		return
 	}
 
@@ -136,6 +170,7 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -146,6 +181,11 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -189,7 +229,18 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
-	if !data.Variables.IsNull() || responseData.Variables != "" {
+	if !data.VariablesMap.IsNull() {
+		variablesMap, diags := variablesMapFromAPI(data.VariablesMap, responseData.Variables)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables_map"), variablesMap)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if !data.Variables.IsNull() || responseData.Variables != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables"), responseData.Variables)...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -225,17 +276,24 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !(data.Variables.IsNull()) {
 		bodyData.Variables = data.Variables.ValueString()
 	}
+	if !data.VariablesMap.IsNull() {
+		variables, diags := variablesMapToString(data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.Variables = variables
+	}
 
 	url := fmt.Sprintf("hosts/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API update request", err, withVariablesMapFieldPath(hostAPIFieldPaths, "variables", "variables_map", data.VariablesMap))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *HostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -265,5 +323,10 @@ func (r *HostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *HostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, inventoryScopedImportKey("hosts"))
 }
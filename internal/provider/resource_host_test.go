@@ -2,8 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"math/big"
+	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -134,3 +138,127 @@ resource "%[1]s_host" "%[6]s" {
 }
   `, configprefix.Prefix, acctest.RandString(5), resource.Name, resource.Description, resource.Enabled, rName)
 }
+
+func TestHostResource_offline(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+
+	h := newResourceHarness[HostModel](t, NewHostResource, fake.client())
+
+	created := h.create(HostModel{
+		Id:        types.StringUnknown(),
+		Name:      types.StringValue("web1"),
+		Enabled:   types.BoolValue(true),
+		Inventory: types.Int32Value(int32(inventoryID)),
+		Variables: NewVariablesStringValue(`{"foo":"bar"}`),
+	})
+	if created.Id.IsUnknown() || created.Id.IsNull() {
+		t.Fatal("expected create to set the id")
+	}
+
+	updated := created
+	updated.Description = types.StringValue("updated")
+	updated.Enabled = types.BoolValue(false)
+	updated = h.update(created, updated)
+
+	refreshed, exists := h.read(updated)
+	if !exists {
+		t.Fatal("expected the host to exist after update")
+	}
+	if refreshed.Description.ValueString() != "updated" || refreshed.Enabled.ValueBool() {
+		t.Errorf("unexpected host after update: %+v", refreshed)
+	}
+
+	imported := h.importState(created.Id.ValueString())
+	if imported != refreshed {
+		t.Errorf("expected the imported host to match state, got %+v, want %+v", imported, refreshed)
+	}
+
+	imported = h.importState("inventory/web1")
+	if imported != refreshed {
+		t.Errorf("expected the host imported by name to match state, got %+v, want %+v", imported, refreshed)
+	}
+
+	h.delete(refreshed)
+	if _, exists := h.read(refreshed); exists {
+		t.Error("expected a deleted host to be removed from state")
+	}
+}
+
+func TestHostResource_unitTest(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: fake.protoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "%[1]s_host" "test" {
+  name      = "web1"
+  inventory = %[2]d
+  variables = jsonencode({ foo = "bar" })
+}
+`, configprefix.Prefix, inventoryID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						fmt.Sprintf("%s_host.test", configprefix.Prefix),
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("web1"),
+					),
+				},
+			},
+			{
+				ResourceName:      fmt.Sprintf("%s_host.test", configprefix.Prefix),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestHostResource_variablesMap(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+
+	h := newResourceHarness[HostModel](t, NewHostResource, fake.client())
+
+	variablesMap := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"ansible_host": types.StringType, "ansible_port": types.NumberType},
+		map[string]attr.Value{"ansible_host": types.StringValue("10.0.0.1"), "ansible_port": types.NumberValue(big.NewFloat(22))},
+	))
+
+	created := h.create(HostModel{
+		Id:           types.StringUnknown(),
+		Name:         types.StringValue("web1"),
+		Enabled:      types.BoolValue(true),
+		Inventory:    types.Int32Value(int32(inventoryID)),
+		Variables:    NewVariablesStringValue("---"),
+		VariablesMap: variablesMap,
+	})
+
+	id, _ := strconv.ParseInt(created.Id.ValueString(), 10, 64)
+	if variables := fake.get("hosts", id)["variables"]; !variablesEqual(variables.(string), `{"ansible_host":"10.0.0.1","ansible_port":22}`) {
+		t.Errorf("expected variables_map to be sent as variables, got %q", variables)
+	}
+
+	refreshed, _ := h.read(created)
+	if !refreshed.VariablesMap.Equal(variablesMap) {
+		t.Errorf("expected variables_map to be kept while the controller holds the same variables, got %v", refreshed.VariablesMap)
+	}
+
+	// A prior value that no longer matches is replaced by the controller's variables.
+	drifted := created
+	drifted.VariablesMap = types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"ansible_host": types.StringType},
+		map[string]attr.Value{"ansible_host": types.StringValue("10.0.0.2")},
+	))
+	refreshed, _ = h.read(drifted)
+	if !refreshed.VariablesMap.Equal(variablesMap) {
+		t.Errorf("expected variables_map to be read from the controller, got %v", refreshed.VariablesMap)
+	}
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The attributes that make up a resource's identity. Each one holds a numeric controller id and has the same name
+// in the identity as in state, where the id is kept as a string. Top level objects are identified by their id,
+// and group_host by the ids of the group and host it links.
+type resourceIdentity []string
+
+var (
+	idIdentity        = resourceIdentity{"id"}
+	groupHostIdentity = resourceIdentity{"group_id", "host_id"}
+)
+
+func (ri resourceIdentity) schema() identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(ri))
+	for _, name := range ri {
+		description := "Numeric ID of the object."
+		if name != "id" {
+			description = fmt.Sprintf("Numeric ID of the %s.", strings.ReplaceAll(strings.TrimSuffix(name, "_id"), "_", " "))
+		}
+
+		attributes[name] = identityschema.Int64Attribute{
+			RequiredForImport: true,
+			Description:       description,
+		}
+	}
+
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// Sets identity from the ids in state. It's called with the new state in Create and Update, and with the prior
+// state in Read, as the ids never change once the object exists.
+func (ri resourceIdentity) set(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil {
+		return diags
+	}
+
+	for _, name := range ri {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		id, err := strconv.ParseInt(value.ValueString(), 10, 64)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Unable convert id from string to int",
+				fmt.Sprintf("Unable to convert %s: %v.", name, value.ValueString()))
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), types.Int64Value(id))...)
+	}
+
+	return diags
+}
+
+// Copies the ids of an `import { identity = {...} }` block into state. Callers only use it when req.ID is empty,
+// which is how the framework signals an import by identity rather than by import id.
+func (ri resourceIdentity) importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.Identity == nil {
+		resp.Diagnostics.AddError(
+			"Missing import id",
+			"Either an import id or a resource identity must be given to import this resource.")
+		return
+	}
+
+	for _, name := range ri {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(name), &id)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), strconv.FormatInt(id.ValueInt64(), 10))...)
+	}
+}
+
+// The identity of an association resource, which manages every child in one sub-collection of its parent: the
+// parent's id, plus the ids of the children, named as in state, where they're a set or list of numbers. Only the
+// parent is needed to import, as the children are read from the controller. The children change along with the
+// association, so resources using it declare a mutable identity.
+type associationIdentity struct {
+	parent   string
+	children string
+	// what the ids are of, for the identity schema's descriptions
+	parentName   string
+	childrenName string
+}
+
+var (
+	jobTemplateCredentialIdentity           = associationIdentity{"job_template_id", "credential_ids", "job template", "credentials"}
+	jobTemplateInstanceGroupIdentity        = associationIdentity{"job_template_id", "instance_groups_ids", "job template", "instance groups"}
+	jobTemplateLabelIdentity                = associationIdentity{"job_template_id", "label_ids", "job template", "labels"}
+	jobTemplateNotificationIdentity         = associationIdentity{"job_template_id", "notif_template_ids", "job template", "notification templates"}
+	workflowJobTemplateNotificationIdentity = associationIdentity{"workflow_job_template_id", "notif_template_ids", "workflow job template", "notification templates"}
+	workflowNodeCredentialIdentity          = associationIdentity{"id", "credential_ids", "workflow job template node", "credentials"}
+	workflowNodeLabelIdentity               = associationIdentity{"id", "label_ids", "workflow job template node", "labels"}
+	workflowNodeSuccessIdentity             = associationIdentity{"id", "success_ids", "workflow job template node", "nodes run when it succeeds"}
+	workflowNodeFailureIdentity             = associationIdentity{"id", "failure_ids", "workflow job template node", "nodes run when it fails"}
+	workflowNodeAlwaysIdentity              = associationIdentity{"id", "always_ids", "workflow job template node", "nodes always run after it"}
+)
+
+func (ai associationIdentity) schema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			ai.parent: identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("Numeric ID of the %s.", ai.parentName),
+			},
+			ai.children: identityschema.ListAttribute{
+				ElementType:       types.Int64Type,
+				OptionalForImport: true,
+				Description:       fmt.Sprintf("Numeric IDs of the %s, in ascending order. Not needed to import, which reads them from the controller.", ai.childrenName),
+			},
+		},
+	}
+}
+
+// Sets identity from the ids in state. It's called with the new state in Create, Read and Update, as the children
+// can change whenever the resource is applied or refreshed.
+func (ai associationIdentity) set(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	diags := resourceIdentity{ai.parent}.set(ctx, state, identity)
+	if diags.HasError() {
+		return diags
+	}
+
+	var children []int64
+	diags.Append(state.GetAttribute(ctx, path.Root(ai.children), &children)...)
+	if diags.HasError() {
+		return diags
+	}
+	slices.Sort(children)
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(ai.children), children)...)
+
+	return diags
+}
+
+// Copies the parent's id of an `import { identity = {...} }` block into state. Callers only use it when req.ID is
+// empty, which is how the framework signals an import by identity rather than by import id.
+func (ai associationIdentity) importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceIdentity{ai.parent}.importState(ctx, req, resp)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourceIdentity_allResources(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range (&theProvider{}).Resources(ctx) {
+		r := newResource()
+
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "test"}, metadata)
+
+		identifiable, ok := r.(resource.ResourceWithIdentity)
+		if !ok {
+			t.Errorf("%s: does not implement resource identity", metadata.TypeName)
+			continue
+		}
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		identityResp := &resource.IdentitySchemaResponse{}
+		identifiable.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+		if identityResp.Diagnostics.HasError() {
+			t.Errorf("%s: %v", metadata.TypeName, identityResp.Diagnostics)
+			continue
+		}
+
+		// every identity attribute is copied from and to a string id attribute of the same name, or for the
+		// children of an association, from a set or list of ids
+		for name, attribute := range identityResp.IdentitySchema.Attributes {
+			switch attribute.(type) {
+			case identityschema.ListAttribute:
+				switch schemaResp.Schema.Attributes[name].(type) {
+				case schema.SetAttribute, schema.ListAttribute:
+				default:
+					t.Errorf("%s: identity attribute %s has no set or list attribute in the resource schema", metadata.TypeName, name)
+				}
+				if !metadata.ResourceBehavior.MutableIdentity {
+					t.Errorf("%s: identity attribute %s changes with the association, but the identity isn't mutable", metadata.TypeName, name)
+				}
+			default:
+				if _, ok := schemaResp.Schema.Attributes[name].(schema.StringAttribute); !ok {
+					t.Errorf("%s: identity attribute %s has no string attribute in the resource schema", metadata.TypeName, name)
+				}
+			}
+		}
+	}
+}
+
+func TestResourceIdentity_offline(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+	groupID := fake.seed("groups", map[string]any{"name": "web", "inventory": inventoryID})
+
+	hosts := newResourceHarness[HostModel](t, NewHostResource, fake.client())
+
+	host := hosts.create(HostModel{
+		Id:        types.StringUnknown(),
+		Name:      types.StringValue("web1"),
+		Enabled:   types.BoolValue(true),
+		Inventory: types.Int32Value(int32(inventoryID)),
+	})
+
+	var identityID types.Int64
+	if diags := hosts.identity.GetAttribute(hosts.ctx, path.Root("id"), &identityID); diags.HasError() {
+		t.Fatalf("reading identity: %v", diags)
+	}
+	if fmt.Sprint(identityID.ValueInt64()) != host.Id.ValueString() {
+		t.Errorf("expected the identity to hold the host id %s, got %d", host.Id.ValueString(), identityID.ValueInt64())
+	}
+
+	imported := hosts.importIdentity(map[string]int64{"id": identityID.ValueInt64()})
+	if imported.Id != host.Id || imported.Name != host.Name {
+		t.Errorf("expected the host imported by identity to match state, got %+v, want %+v", imported, host)
+	}
+
+	memberships := newResourceHarness[GroupHostModel](t, NewGroupHostResource, fake.client())
+
+	membership := memberships.create(GroupHostModel{
+		GroupId: types.StringValue(fmt.Sprint(groupID)),
+		HostId:  host.Id,
+	})
+
+	importedMembership := memberships.importIdentity(map[string]int64{"group_id": groupID, "host_id": identityID.ValueInt64()})
+	if importedMembership != membership {
+		t.Errorf("expected the membership imported by identity to match state, got %+v, want %+v", importedMembership, membership)
+	}
+}
+
+func TestResourceIdentity_association(t *testing.T) {
+	fake := newFakeController(t)
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy"})
+	firstLabelID := fake.seed("labels", map[string]any{"name": "first"})
+	secondLabelID := fake.seed("labels", map[string]any{"name": "second"})
+
+	labels := newResourceHarness[JobTemplateLabelsResourceModel](t, NewJobTemplateLabelsResource, fake.client())
+
+	created := labels.create(JobTemplateLabelsResourceModel{
+		JobTemplateId: types.StringValue(fmt.Sprint(jobTemplateID)),
+		LabelIDs:      types.SetValueMust(types.Int32Type, []attr.Value{types.Int32Value(int32(secondLabelID)), types.Int32Value(int32(firstLabelID))}),
+	})
+
+	identityLabels := func() []int64 {
+		t.Helper()
+
+		var identityJobTemplateID int64
+		var identityLabelIDs []int64
+		if diags := labels.identity.GetAttribute(labels.ctx, path.Root("job_template_id"), &identityJobTemplateID); diags.HasError() {
+			t.Fatalf("reading identity: %v", diags)
+		}
+		if diags := labels.identity.GetAttribute(labels.ctx, path.Root("label_ids"), &identityLabelIDs); diags.HasError() {
+			t.Fatalf("reading identity: %v", diags)
+		}
+		if identityJobTemplateID != jobTemplateID {
+			t.Errorf("expected the identity to hold the job template id %d, got %d", jobTemplateID, identityJobTemplateID)
+		}
+
+		return identityLabelIDs
+	}
+
+	if got, want := identityLabels(), []int64{firstLabelID, secondLabelID}; !slices.Equal(got, want) {
+		t.Errorf("expected the identity to hold the label ids %v, got %v", want, got)
+	}
+
+	// only the parent is needed to import, and the labels are read back into both state and identity
+	imported := labels.importIdentity(map[string]int64{"job_template_id": jobTemplateID})
+	if !imported.LabelIDs.Equal(created.LabelIDs) {
+		t.Errorf("expected the labels imported by identity to match state, got %v, want %v", imported.LabelIDs, created.LabelIDs)
+	}
+	if got, want := identityLabels(), []int64{firstLabelID, secondLabelID}; !slices.Equal(got, want) {
+		t.Errorf("expected the imported identity to hold the label ids %v, got %v", want, got)
+	}
+
+	labels.update(created, JobTemplateLabelsResourceModel{
+		JobTemplateId: created.JobTemplateId,
+		LabelIDs:      types.SetValueMust(types.Int32Type, []attr.Value{types.Int32Value(int32(secondLabelID))}),
+	})
+	if got, want := identityLabels(), []int64{secondLabelID}; !slices.Equal(got, want) {
+		t.Errorf("expected the updated identity to hold the label ids %v, got %v", want, got)
+	}
+}
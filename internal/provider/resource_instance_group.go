@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &InstanceGroupResource{}
 var _ resource.ResourceWithImportState = &InstanceGroupResource{}
+var _ resource.ResourceWithIdentity = &InstanceGroupResource{}
 
 func NewInstanceGroupResource() resource.Resource {
 	return &InstanceGroupResource{}
@@ -74,6 +75,7 @@ func (r *InstanceGroupResource) Schema(ctx context.Context, req resource.SchemaR
 				Default:     int32default.StaticInt32(0),
 			},
 			"pod_spec_override": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "A custom Kubernetes or OpenShift Pod specification in json for ContainerGroups.",
 				Optional:    true,
 			},
@@ -90,11 +92,18 @@ func (r *InstanceGroupResource) Schema(ctx context.Context, req resource.SchemaR
 				Default:     int32default.StaticInt32(0),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx, "the instance group"),
+		},
 	}
 }
 
+func (r *InstanceGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r InstanceGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data InstanceGroupModel
+	var data InstanceGroupResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -153,13 +162,22 @@ func (r *InstanceGroupResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *InstanceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data InstanceGroupModel
+	var data InstanceGroupResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var bodyData InstanceGroupAPIModel
 
 	bodyData.Name = data.Name.ValueString()
@@ -199,16 +217,22 @@ func (r *InstanceGroupResource) Create(ctx context.Context, req resource.CreateR
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *InstanceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data InstanceGroupModel
+	var data InstanceGroupResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -261,13 +285,22 @@ func (r *InstanceGroupResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *InstanceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data InstanceGroupModel
+	var data InstanceGroupResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -303,16 +336,26 @@ func (r *InstanceGroupResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *InstanceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data InstanceGroupModel
+	var data InstanceGroupResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -332,5 +375,10 @@ func (r *InstanceGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *InstanceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, nameImportKey("instance_groups", ""))
 }
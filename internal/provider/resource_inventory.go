@@ -20,6 +20,10 @@ import (
 
 var _ resource.Resource = &InventoryResource{}
 var _ resource.ResourceWithImportState = &InventoryResource{}
+var _ resource.ResourceWithIdentity = &InventoryResource{}
+var _ resource.ResourceWithMoveState = &InventoryResource{}
+
+var inventoryAPIFieldPaths = apiFieldPaths(InventoryModel{})
 
 func NewInventoryResource() resource.Resource {
 	return &InventoryResource{}
@@ -57,11 +61,16 @@ func (r *InventoryResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:    true,
 			},
 			"variables": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Description: "Enter inventory variables using either JSON or YAML syntax.",
 				Optional:    true,
 			},
+			"variables_map": schema.DynamicAttribute{
+				Description: "Variables as an object, i.e. `{ ansible_host = \"10.0.0.1\" }`, instead of the JSON or YAML string of `variables`. Conflicts with `variables`.",
+				Optional:    true,
+			},
 			"kind": schema.StringAttribute{
-				Description: "Set to `smart` for smart inventories",
+				Description: "Set to `smart` for smart inventories. Constructed inventories are created through the controller's `constructed_inventories` endpoint, which this resource doesn't manage.",
 				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.OneOf([]string{"smart"}...),
@@ -72,15 +81,30 @@ func (r *InventoryResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx, "the inventory"),
+		},
 	}
 }
 
+func (r *InventoryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *InventoryResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyInventoryResource.stateMovers()
+}
+
 func (d InventoryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.RequiredTogether(
 			path.MatchRoot("kind"),
 			path.MatchRoot("host_filter"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("variables"),
+			path.MatchRoot("variables_map"),
+		),
 	}
 }
 
@@ -103,7 +127,7 @@ func (r *InventoryResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *InventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data InventoryModel
+	var data InventoryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -111,6 +135,15 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var bodyData InventoryAPIModel
 
 	if !(data.Name.IsNull()) {
@@ -125,6 +158,14 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 	if !(data.Variables.IsNull()) {
 		bodyData.Variables = data.Variables.ValueString()
 	}
+	if !data.VariablesMap.IsNull() {
+		variables, diags := variablesMapToString(data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.Variables = variables
+	}
 	if !(data.Kind.IsNull()) {
 		bodyData.Kind = data.Kind.ValueString()
 	}
@@ -135,9 +176,7 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 	url := "inventories/"
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API http request", err, withVariablesMapFieldPath(inventoryAPIFieldPaths, "variables", "variables_map", data.VariablesMap))
 		return
 	}
 
@@ -154,16 +193,22 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *InventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data InventoryModel
+	var data InventoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -217,7 +262,18 @@ func (r *InventoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 	}
 
-	if !data.Variables.IsNull() || responseData.Variables != "" {
+	if !data.VariablesMap.IsNull() {
+		variablesMap, diags := variablesMapFromAPI(data.VariablesMap, responseData.Variables)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables_map"), variablesMap)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if !data.Variables.IsNull() || responseData.Variables != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables"), responseData.Variables)...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -240,13 +296,22 @@ func (r *InventoryResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data InventoryModel
+	var data InventoryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -269,6 +334,14 @@ func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateReque
 	if !(data.Variables.IsNull()) {
 		bodyData.Variables = data.Variables.ValueString()
 	}
+	if !data.VariablesMap.IsNull() {
+		variables, diags := variablesMapToString(data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.Variables = variables
+	}
 	if !(data.Kind.IsNull()) {
 		bodyData.Kind = data.Kind.ValueString()
 	}
@@ -279,23 +352,31 @@ func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateReque
 	url := fmt.Sprintf("inventories/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API update request", err, withVariablesMapFieldPath(inventoryAPIFieldPaths, "variables", "variables_map", data.VariablesMap))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *InventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data InventoryModel
+	var data InventoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -315,5 +396,10 @@ func (r *InventoryResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *InventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("inventories", ""))
 }
@@ -24,6 +24,7 @@ import (
 
 var _ resource.Resource = &InventorySourceResource{}
 var _ resource.ResourceWithImportState = &InventorySourceResource{}
+var _ resource.ResourceWithIdentity = &InventorySourceResource{}
 
 func NewInventorySourceResource() resource.Resource {
 	return &InventorySourceResource{}
@@ -113,6 +114,7 @@ func (r *InventorySourceResource) Schema(ctx context.Context, req resource.Schem
 				Computed:    true,
 			},
 			"source_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Optional:    true,
 				Default:     stringdefault.StaticString("---"),
 				Computed:    true,
@@ -142,11 +144,18 @@ func (r *InventorySourceResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx, "the inventory source"),
+		},
 	}
 }
 
+func (r *InventorySourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r InventorySourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data InventorySourceModel
+	var data InventorySourceResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -218,13 +227,22 @@ func (r *InventorySourceResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *InventorySourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data InventorySourceModel
+	var data InventorySourceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var bodyData InventorySourceAPIModel
 
 	bodyData.Name = data.Name.ValueString()
@@ -299,10 +317,11 @@ func (r *InventorySourceResource) Create(ctx context.Context, req resource.Creat
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *InventorySourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data InventorySourceModel
+	var data InventorySourceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -310,6 +329,11 @@ func (r *InventorySourceResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -441,7 +465,7 @@ func (r *InventorySourceResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *InventorySourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data InventorySourceModel
+	var data InventorySourceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -449,6 +473,15 @@ func (r *InventorySourceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -519,10 +552,11 @@ func (r *InventorySourceResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *InventorySourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data InventorySourceModel
+	var data InventorySourceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -530,6 +564,15 @@ func (r *InventorySourceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -549,5 +592,10 @@ func (r *InventorySourceResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *InventorySourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, inventoryScopedImportKey("inventory_sources"))
 }
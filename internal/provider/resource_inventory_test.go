@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -192,3 +193,41 @@ resource "%[1]s_inventory" "%[8]s" {
 }
   `, configprefix.Prefix, acctest.RandString(5), resource.Name, resource.Description, resource.Variables, resource.Kind, resource.HostFilter, rName)
 }
+
+func TestInventoryResource_offline(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+
+	h := newResourceHarness[InventoryResourceModel](t, NewInventoryResource, fake.client())
+
+	created := h.create(InventoryResourceModel{InventoryModel: InventoryModel{
+		Id:           types.StringUnknown(),
+		Name:         types.StringValue("inventory"),
+		Organization: types.Int32Value(int32(organizationID)),
+		Variables:    NewVariablesStringValue(`{"env":"test"}`),
+	}, Timeouts: resourceTimeouts(nil)})
+
+	updated := created
+	updated.Name = types.StringValue("renamed")
+	updated = h.update(created, updated)
+
+	imported := h.importState(created.Id.ValueString())
+	if imported.Name.ValueString() != "renamed" || imported.Variables != updated.Variables {
+		t.Errorf("unexpected imported inventory: %+v", imported)
+	}
+
+	// a second inventory with the same name in the organization is rejected with a field error
+	_, diags := h.tryCreate(InventoryResourceModel{InventoryModel: InventoryModel{
+		Id:           types.StringUnknown(),
+		Name:         types.StringValue("renamed"),
+		Organization: types.Int32Value(int32(organizationID)),
+	}, Timeouts: resourceTimeouts(nil)})
+	if !diags.HasError() {
+		t.Error("expected a duplicate inventory to be rejected")
+	}
+
+	h.delete(imported)
+	if _, exists := h.read(imported); exists {
+		t.Error("expected a deleted inventory to be removed from state")
+	}
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &JobLaunchResource{}
+var _ resource.ResourceWithIdentity = &JobLaunchResource{}
+
+// How long a launched job is waited for when the timeouts block doesn't say.
+const defaultJobLaunchTimeout = 60 * time.Minute
+
+func NewJobLaunchResource() resource.Resource {
+	return &JobLaunchResource{}
+}
+
+type JobLaunchResource struct {
+	client *providerClient
+}
+
+func (r *JobLaunchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_launch"
+}
+
+func (r *JobLaunchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Launches a job template and waits for the job to finish, failing the apply when the job fails. Changing any argument other than `wait_for_completion` and `timeouts`, such as a value in `triggers`, launches a new job. Destroying the resource only removes it from state; the job stays in the controller's history.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the launched job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_template": schema.Int32Attribute{
+				Description: "ID of the job template to launch.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"extra_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
+				Description: "Extra variables for the job, in JSON or YAML format. The job template must prompt for variables on launch, or enable its survey.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"limit": schema.StringAttribute{
+				Description: "Host pattern limiting the hosts the job runs against. The job template must prompt for the limit on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inventory": schema.Int32Attribute{
+				Description: "ID of the inventory to run the job against. The job template must prompt for the inventory on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": schema.SetAttribute{
+				ElementType: types.Int32Type,
+				Description: "IDs of the credentials to run the job with, replacing the job template's. The job template must prompt for credentials on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_tags": schema.StringAttribute{
+				Description: "Comma separated tags to run. The job template must prompt for tags on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_tags": schema.StringAttribute{
+				Description: "Comma separated tags to skip. The job template must prompt for skip tags on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_type": schema.StringAttribute{
+				Description: "Either `run` or `check`. The job template must prompt for the job type on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"run", "check"}...),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Arbitrary values that launch a new job when any of them changes, i.e. `{ release = var.release }`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the job to finish, and fail when it does not succeed. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the job, i.e. `successful`, as of the last refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"elapsed": schema.Float64Attribute{
+				Description: "Seconds the job ran for.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"artifacts": schema.DynamicAttribute{
+				Description: "Artifacts the job's playbook saved with the `set_stats` module.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the job to finish, as a duration such as `\"30m\"` or `\"2h\"`. Defaults to `\"60m\"`.",
+			}),
+		},
+	}
+}
+
+func (r *JobLaunchResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *JobLaunchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = configureData
+}
+
+func (r *JobLaunchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JobLaunchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultJobLaunchTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var bodyData JobLaunchAPIModel
+	bodyData.ExtraVars = data.ExtraVars.ValueString()
+	bodyData.Limit = data.Limit.ValueString()
+	bodyData.Inventory = int(data.Inventory.ValueInt32())
+	bodyData.JobTags = data.JobTags.ValueString()
+	bodyData.SkipTags = data.SkipTags.ValueString()
+	bodyData.JobType = data.JobType.ValueString()
+
+	if !data.Credentials.IsNull() {
+		resp.Diagnostics.Append(data.Credentials.ElementsAs(ctx, &bodyData.Credentials, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	url := fmt.Sprintf("job_templates/%d/launch/", data.JobTemplate.ValueInt32())
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error launching job template",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	jobID, ok := returnedData["job"].(float64)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error retrieving computed values",
+			"Could not retrieve job.")
+		return
+	}
+
+	warnIgnoredLaunchFields(&resp.Diagnostics, returnedData)
+
+	data.Id = types.StringValue(strconv.Itoa(int(jobID)))
+
+	jobURL := fmt.Sprintf("jobs/%d/", int(jobID))
+	var job unifiedJobAPIModel
+	if data.WaitForCompletion.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+
+		job, err = r.client.waitForUnifiedJob(waitCtx, jobURL, nil)
+	} else {
+		job, _, err = r.client.getUnifiedJob(ctx, jobURL)
+	}
+	if err != nil {
+		// the job was launched, so it's kept in state, where the error taints it to be launched again
+		data.Status = types.StringValue(job.Status)
+		data.Elapsed = types.Float64Value(job.Elapsed)
+		data.Artifacts = types.DynamicNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+		resp.Diagnostics.AddError(
+			"Error waiting for job",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(setJobLaunchResult(&data, job)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() && job.Status != "successful" {
+		resp.Diagnostics.AddError(
+			"Job did not succeed",
+			job.failureDescription())
+	}
+}
+
+func (r *JobLaunchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JobLaunchModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+
+	job, statusCode, err := r.client.getUnifiedJob(ctx, fmt.Sprintf("jobs/%d/", id))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	// Cleaning up old jobs is routine, and shouldn't launch the job again, so the last known result is kept.
+	if statusCode == 404 {
+		return
+	}
+
+	resp.Diagnostics.Append(setJobLaunchResult(&data, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only wait_for_completion and timeouts can change without launching a new job, and neither needs the API.
+func (r *JobLaunchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JobLaunchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+}
+
+// Jobs can't be undone, so destroying the resource leaves the job in the controller's history.
+func (r *JobLaunchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func setJobLaunchResult(data *JobLaunchModel, job unifiedJobAPIModel) diag.Diagnostics {
+	data.Status = types.StringValue(job.Status)
+	data.Elapsed = types.Float64Value(job.Elapsed)
+
+	return variablesApiToDynamicObject(map[string]any(job.Artifacts), &data.Artifacts)
+}
+
+// The controller ignores values the template doesn't prompt for rather than rejecting the launch, and lists
+// them in the response's ignored_fields.
+func warnIgnoredLaunchFields(diags *diag.Diagnostics, returnedData map[string]any) {
+	ignoredFields, _ := returnedData["ignored_fields"].(map[string]any)
+	for field := range ignoredFields {
+		diags.AddWarning(
+			"Launch value ignored",
+			fmt.Sprintf("The controller ignored %s, as the template doesn't prompt for it on launch.", field))
+	}
+}
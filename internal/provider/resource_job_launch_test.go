@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Shortens the wait between polls of a running job for the rest of the test.
+func fastJobPolling(t *testing.T) {
+	interval := jobPollInterval
+	jobPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { jobPollInterval = interval })
+}
+
+func jobLaunchTimeouts(create string) timeouts.Value {
+	attributeTypes := map[string]attr.Type{"create": types.StringType}
+	if create == "" {
+		return timeouts.Value{Object: types.ObjectNull(attributeTypes)}
+	}
+
+	return timeouts.Value{Object: types.ObjectValueMust(attributeTypes, map[string]attr.Value{"create": types.StringValue(create)})}
+}
+
+func plannedJobLaunch(jobTemplateID int64) JobLaunchModel {
+	return JobLaunchModel{
+		Id:                types.StringUnknown(),
+		JobTemplate:       types.Int32Value(int32(jobTemplateID)),
+		ExtraVars:         NewVariablesStringNull(),
+		Limit:             types.StringNull(),
+		Inventory:         types.Int32Null(),
+		Credentials:       types.SetNull(types.Int32Type),
+		JobTags:           types.StringNull(),
+		SkipTags:          types.StringNull(),
+		JobType:           types.StringNull(),
+		Triggers:          types.MapNull(types.StringType),
+		WaitForCompletion: types.BoolValue(true),
+		Status:            types.StringUnknown(),
+		Elapsed:           types.Float64Unknown(),
+		Artifacts:         types.DynamicUnknown(),
+		Timeouts:          jobLaunchTimeouts(""),
+	}
+}
+
+func TestJobLaunchResource_offline(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy"})
+	credentialID := fake.seed("credentials", map[string]any{"name": "machine"})
+	fake.setJobOutcome(map[string]any{"status": "successful", "elapsed": 12.5, "artifacts": map[string]any{"release": "1.2.3"}})
+
+	h := newResourceHarness[JobLaunchModel](t, NewJobLaunchResource, fake.client())
+
+	planned := plannedJobLaunch(jobTemplateID)
+	planned.Limit = types.StringValue("web*")
+	planned.ExtraVars = NewVariablesStringValue("release: 1.2.3\n")
+	planned.Credentials = types.SetValueMust(types.Int32Type, []attr.Value{types.Int32Value(int32(credentialID))})
+	planned.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{"revision": types.StringValue("abc")})
+
+	created := h.create(planned)
+	if created.Status.ValueString() != "successful" || created.Elapsed.ValueFloat64() != 12.5 {
+		t.Errorf("expected the finished job's status and elapsed time, got %v and %v", created.Status, created.Elapsed)
+	}
+
+	expectedArtifacts := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"release": types.StringType},
+		map[string]attr.Value{"release": types.StringValue("1.2.3")},
+	))
+	if !created.Artifacts.Equal(expectedArtifacts) {
+		t.Errorf("expected the job's artifacts, got %v", created.Artifacts)
+	}
+
+	jobID, _ := strconv.ParseInt(created.Id.ValueString(), 10, 64)
+	job := fake.get("jobs", jobID)
+	if job["limit"] != "web*" || job["extra_vars"] != "release: 1.2.3\n" || job["job_template"] != jobTemplateID {
+		t.Errorf("expected the launch values to be sent to the controller, got %+v", job)
+	}
+	if credentials, _ := job["credentials"].([]any); len(credentials) != 1 || credentials[0] != float64(credentialID) {
+		t.Errorf("expected the credentials to be sent to the controller, got %v", job["credentials"])
+	}
+	if _, ok := job["triggers"]; ok {
+		t.Error("expected triggers not to be sent to the controller")
+	}
+
+	polls := 0
+	for _, request := range fake.requestLog() {
+		if strings.HasPrefix(request, "GET /api/v2/jobs/") {
+			polls++
+		}
+	}
+	if polls < 2 {
+		t.Errorf("expected the pending job to be polled until it finished, got %d polls", polls)
+	}
+
+	refreshed, exists := h.read(created)
+	if !exists || !refreshed.Artifacts.Equal(created.Artifacts) || refreshed.Status != created.Status {
+		t.Errorf("expected a refresh to keep the finished job, got %+v", refreshed)
+	}
+
+	// Cleaning up old jobs mustn't launch the job again.
+	h.delete(created)
+	fake.mu.Lock()
+	delete(fake.objects["jobs"], jobID)
+	fake.mu.Unlock()
+	if refreshed, exists := h.read(created); !exists || refreshed.Status.ValueString() != "successful" {
+		t.Errorf("expected a purged job to keep its last known result, got %+v", refreshed)
+	}
+}
+
+func TestJobLaunchResource_failedJob(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy"})
+	fake.setJobOutcome(map[string]any{"status": "failed", "failed": true, "job_explanation": "Previous Task Failed"})
+
+	h := newResourceHarness[JobLaunchModel](t, NewJobLaunchResource, fake.client())
+
+	_, diags := h.tryCreate(plannedJobLaunch(jobTemplateID))
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "Previous Task Failed") {
+		t.Errorf("expected the failed job to fail the apply with its explanation, got %v", diags)
+	}
+
+	// without waiting, the launch succeeds regardless of how the job turns out
+	planned := plannedJobLaunch(jobTemplateID)
+	planned.WaitForCompletion = types.BoolValue(false)
+	created := h.create(planned)
+	if created.Status.ValueString() != "pending" {
+		t.Errorf("expected the job to be left pending, got %v", created.Status)
+	}
+}
+
+func TestJobLaunchResource_timeout(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy"})
+	fake.setJobOutcome(map[string]any{"status": "running"})
+
+	h := newResourceHarness[JobLaunchModel](t, NewJobLaunchResource, fake.client())
+
+	planned := plannedJobLaunch(jobTemplateID)
+	planned.Timeouts = jobLaunchTimeouts("100ms")
+
+	_, diags := h.tryCreate(planned)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), `last seen with status "running"`) {
+		t.Errorf("expected the create timeout to stop waiting for the running job, got %v", diags)
+	}
+
+	if !slices.ContainsFunc(fake.requestLog(), func(request string) bool { return strings.HasPrefix(request, "POST /api/v2/job_templates/") }) {
+		t.Error("expected the job template to be launched")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,6 +24,11 @@ import (
 
 var _ resource.Resource = &JobTemplateResource{}
 var _ resource.ResourceWithImportState = &JobTemplateResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateResource{}
+var _ resource.ResourceWithConfigValidators = &JobTemplateResource{}
+var _ resource.ResourceWithMoveState = &JobTemplateResource{}
+
+var jobTemplateAPIFieldPaths = apiFieldPaths(JobTemplateModel{})
 
 func NewJobTemplateResource() resource.Resource {
 	return &JobTemplateResource{}
@@ -107,11 +113,16 @@ func (r *JobTemplateResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"extra_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
 				Optional:    true,
 				Default:     stringdefault.StaticString("---"),
 				Computed:    true,
 				Description: "Specify `extra_vars` for the template. Default value is `\"---\"`",
 			},
+			"extra_vars_map": schema.DynamicAttribute{
+				Description: "Extra variables as an object, i.e. `{ release = \"1.2.3\" }`, instead of the JSON or YAML string of `extra_vars`. Conflicts with `extra_vars`.",
+				Optional:    true,
+			},
 			"job_tags": schema.StringAttribute{
 				Optional:    true,
 				Default:     stringdefault.StaticString(""),
@@ -311,6 +322,23 @@ func (r *JobTemplateResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *JobTemplateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *JobTemplateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyJobTemplateResource.stateMovers()
+}
+
+func (r *JobTemplateResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("extra_vars"),
+			path.MatchRoot("extra_vars_map"),
+		),
+	}
+}
+
 func (r JobTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data JobTemplateModel
 
@@ -397,6 +425,14 @@ func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateReq
 	if !(data.ExtraVars.IsNull()) {
 		bodyData.ExtraVars = data.ExtraVars.ValueString()
 	}
+	if !data.ExtraVarsMap.IsNull() {
+		extraVars, diags := variablesMapToString(data.ExtraVarsMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.ExtraVars = extraVars
+	}
 	if !(data.JobTags.IsNull()) {
 		bodyData.JobTags = data.JobTags.ValueString()
 	}
@@ -500,9 +536,7 @@ func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateReq
 	url := "job_templates/"
 	returnedData, statusCode, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{200, 201}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API http request", err, withVariablesMapFieldPath(jobTemplateAPIFieldPaths, "extra_vars", "extra_vars_map", data.ExtraVarsMap))
 		return
 	}
 
@@ -525,6 +559,7 @@ func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateReq
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -535,6 +570,11 @@ func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -625,9 +665,22 @@ func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("extra_vars"), responseData.ExtraVars)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !data.ExtraVarsMap.IsNull() {
+		extraVarsMap, diags := variablesMapFromAPI(data.ExtraVarsMap, responseData.ExtraVars)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("extra_vars_map"), extraVarsMap)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("extra_vars"), responseData.ExtraVars)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("job_tags"), responseData.JobTags)...)
@@ -849,6 +902,14 @@ func (r *JobTemplateResource) Update(ctx context.Context, req resource.UpdateReq
 	bodyData.Limit = data.Limit.ValueString()
 	bodyData.Verbosity = int(data.Verbosity.ValueInt32())
 	bodyData.ExtraVars = data.ExtraVars.ValueString()
+	if !data.ExtraVarsMap.IsNull() {
+		extraVars, diags := variablesMapToString(data.ExtraVarsMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		bodyData.ExtraVars = extraVars
+	}
 	bodyData.JobTags = data.JobTags.ValueString()
 	bodyData.ForceHandlers = data.ForceHandlers.ValueBool()
 	bodyData.SkipTags = data.SkipTags.ValueString()
@@ -894,13 +955,12 @@ func (r *JobTemplateResource) Update(ctx context.Context, req resource.UpdateReq
 	url := fmt.Sprintf("job_templates/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API update request", err, withVariablesMapFieldPath(jobTemplateAPIFieldPaths, "extra_vars", "extra_vars_map", data.ExtraVarsMap))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -929,5 +989,10 @@ func (r *JobTemplateResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *JobTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &JobTemplateCredentialResource{}
 var _ resource.ResourceWithImportState = &JobTemplateCredentialResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateCredentialResource{}
 
 func NewJobTemplateCredentialResource() resource.Resource {
 	return &JobTemplateCredentialResource{}
@@ -32,6 +33,7 @@ type JobTemplateCredentialResourceModel struct {
 
 func (r *JobTemplateCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_job_template_credential"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *JobTemplateCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *JobTemplateCredentialResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *JobTemplateCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = jobTemplateCredentialIdentity.schema()
+}
+
 func (r *JobTemplateCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *JobTemplateCredentialResource) Create(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateCredentialIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *JobTemplateCredentialResource) Read(ctx context.Context, req resource.R
 
 	url := fmt.Sprintf("job_templates/%d/credentials/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -162,6 +169,7 @@ func (r *JobTemplateCredentialResource) Read(ctx context.Context, req resource.R
 	}
 	data.CredentialIds = listValue
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateCredentialIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,7 +189,7 @@ func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource
 
 	url := fmt.Sprintf("job_templates/%d/credentials/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -217,6 +225,7 @@ func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource
 		if !slices.Contains(PlanCredIds, v) {
 			var bodyData CredentialDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -239,6 +248,7 @@ func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource
 		}
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateCredentialIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -284,5 +294,10 @@ func (r *JobTemplateCredentialResource) Delete(ctx context.Context, req resource
 }
 
 func (r *JobTemplateCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("job_template_id"), req, resp)
+	if req.ID == "" {
+		jobTemplateCredentialIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("job_template_id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -158,3 +160,43 @@ resource "%[1]s_job_template_credential" "%[3]s" {
 }
   `, configprefix.Prefix, acctest.RandString(5), rName, rName+"a", rName+"b")
 }
+
+func TestJobTemplateCredentialResource_offline(t *testing.T) {
+	fake := newFakeController(t)
+	jobTemplateID := fake.seed("job_templates", map[string]any{"name": "deploy"})
+	machineID := fake.seed("credentials", map[string]any{"name": "machine"})
+	vaultID := fake.seed("credentials", map[string]any{"name": "vault"})
+
+	h := newResourceHarness[JobTemplateCredentialResourceModel](t, NewJobTemplateCredentialResource, fake.client())
+
+	credentialIDs := func(ids ...int64) types.Set {
+		values := make([]attr.Value, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, types.Int32Value(int32(id)))
+		}
+		return types.SetValueMust(types.Int32Type, values)
+	}
+
+	created := h.create(JobTemplateCredentialResourceModel{
+		JobTemplateId: types.StringValue(fmt.Sprint(jobTemplateID)),
+		CredentialIds: credentialIDs(machineID),
+	})
+
+	updated := created
+	updated.CredentialIds = credentialIDs(vaultID)
+	updated = h.update(created, updated)
+
+	if associated := fake.associated("job_templates", jobTemplateID, "credentials"); len(associated) != 1 || associated[0] != vaultID {
+		t.Errorf("expected only the vault credential to be associated, got: %v", associated)
+	}
+
+	imported := h.importState(fmt.Sprint(jobTemplateID))
+	if !imported.CredentialIds.Equal(updated.CredentialIds) {
+		t.Errorf("expected the imported credentials to match, got: %v", imported.CredentialIds)
+	}
+
+	h.delete(imported)
+	if associated := fake.associated("job_templates", jobTemplateID, "credentials"); len(associated) != 0 {
+		t.Errorf("expected every credential to be disassociated, got: %v", associated)
+	}
+}
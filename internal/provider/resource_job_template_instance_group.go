@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &JobTemplateInstanceGroupsResource{}
 var _ resource.ResourceWithImportState = &JobTemplateInstanceGroupsResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateInstanceGroupsResource{}
 
 func NewJobTemplateInstanceGroupsResource() resource.Resource {
 	return &JobTemplateInstanceGroupsResource{}
@@ -32,6 +33,7 @@ type JobTemplateInstanceGroupsResourceModel struct {
 
 func (r *JobTemplateInstanceGroupsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_job_template_instance_group"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *JobTemplateInstanceGroupsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *JobTemplateInstanceGroupsResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *JobTemplateInstanceGroupsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = jobTemplateInstanceGroupIdentity.schema()
+}
+
 func (r *JobTemplateInstanceGroupsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *JobTemplateInstanceGroupsResource) Create(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateInstanceGroupIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateInstanceGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *JobTemplateInstanceGroupsResource) Read(ctx context.Context, req resour
 
 	url := fmt.Sprintf("job_templates/%d/instance_groups/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -159,6 +166,7 @@ func (r *JobTemplateInstanceGroupsResource) Read(ctx context.Context, req resour
 	data.InstanceGroupsIDs, _ = types.ListValueFrom(context.Background(), types.Int32Type, tfRelatedIds)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateInstanceGroupIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -178,7 +186,7 @@ func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req reso
 
 	url := fmt.Sprintf("job_templates/%d/instance_groups/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -213,6 +221,7 @@ func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req reso
 		for _, v := range tfRelatedIds {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -233,6 +242,7 @@ func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateInstanceGroupIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateInstanceGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -277,5 +287,10 @@ func (r *JobTemplateInstanceGroupsResource) Delete(ctx context.Context, req reso
 }
 
 func (r *JobTemplateInstanceGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("job_template_id"), req, resp)
+	if req.ID == "" {
+		jobTemplateInstanceGroupIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("job_template_id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
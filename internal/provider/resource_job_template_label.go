@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &JobTemplateLabelsResource{}
 var _ resource.ResourceWithImportState = &JobTemplateLabelsResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateLabelsResource{}
 
 func NewJobTemplateLabelsResource() resource.Resource {
 	return &JobTemplateLabelsResource{}
@@ -32,6 +33,7 @@ type JobTemplateLabelsResourceModel struct {
 
 func (r *JobTemplateLabelsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_job_template_label"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *JobTemplateLabelsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *JobTemplateLabelsResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *JobTemplateLabelsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = jobTemplateLabelIdentity.schema()
+}
+
 func (r *JobTemplateLabelsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *JobTemplateLabelsResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateLabelIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *JobTemplateLabelsResource) Read(ctx context.Context, req resource.ReadR
 
 	url := fmt.Sprintf("job_templates/%d/labels/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -163,6 +170,7 @@ func (r *JobTemplateLabelsResource) Read(ctx context.Context, req resource.ReadR
 	data.LabelIDs = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateLabelIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -182,7 +190,7 @@ func (r *JobTemplateLabelsResource) Update(ctx context.Context, req resource.Upd
 
 	url := fmt.Sprintf("job_templates/%d/labels/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -218,6 +226,7 @@ func (r *JobTemplateLabelsResource) Update(ctx context.Context, req resource.Upd
 		if !slices.Contains(PlanLabelIds, v) {
 			var bodyData LabelDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -241,6 +250,7 @@ func (r *JobTemplateLabelsResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateLabelIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateLabelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -285,5 +295,10 @@ func (r *JobTemplateLabelsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *JobTemplateLabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("job_template_id"), req, resp)
+	if req.ID == "" {
+		jobTemplateLabelIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("job_template_id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &JobTemplateNotifTemplErrResource{}
 var _ resource.ResourceWithImportState = &JobTemplateNotifTemplErrResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateNotifTemplErrResource{}
 
 func NewJobTemplateNotifTemplErrResource() resource.Resource {
 	return &JobTemplateNotifTemplErrResource{}
@@ -32,6 +33,7 @@ type JobTemplateNotifTemplErrResourceModel struct {
 
 func (r *JobTemplateNotifTemplErrResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_job_template_notification_template_error"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *JobTemplateNotifTemplErrResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *JobTemplateNotifTemplErrResource) Schema(ctx context.Context, req resou
 	}
 }
 
+func (r *JobTemplateNotifTemplErrResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = jobTemplateNotificationIdentity.schema()
+}
+
 func (r *JobTemplateNotifTemplErrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *JobTemplateNotifTemplErrResource) Create(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateNotifTemplErrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *JobTemplateNotifTemplErrResource) Read(ctx context.Context, req resourc
 
 	url := fmt.Sprintf("job_templates/%d/notification_templates_error/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -163,6 +170,7 @@ func (r *JobTemplateNotifTemplErrResource) Read(ctx context.Context, req resourc
 	data.NotifTEmplateIDs = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateNotifTemplErrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -182,7 +190,7 @@ func (r *JobTemplateNotifTemplErrResource) Update(ctx context.Context, req resou
 
 	url := fmt.Sprintf("job_templates/%d/notification_templates_error/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -218,6 +226,7 @@ func (r *JobTemplateNotifTemplErrResource) Update(ctx context.Context, req resou
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -241,6 +250,7 @@ func (r *JobTemplateNotifTemplErrResource) Update(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateNotifTemplErrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -285,5 +295,10 @@ func (r *JobTemplateNotifTemplErrResource) Delete(ctx context.Context, req resou
 }
 
 func (r *JobTemplateNotifTemplErrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("job_template_id"), req, resp)
+	if req.ID == "" {
+		jobTemplateNotificationIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("job_template_id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &JobTemplateNotifTemplSuccessResource{}
 var _ resource.ResourceWithImportState = &JobTemplateNotifTemplSuccessResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateNotifTemplSuccessResource{}
 
 func NewJobTemplateNotifTemplSuccessResource() resource.Resource {
 	return &JobTemplateNotifTemplSuccessResource{}
@@ -32,6 +33,7 @@ type JobTemplateNotifTemplSuccessResourceModel struct {
 
 func (r *JobTemplateNotifTemplSuccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_job_template_notification_template_success"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *JobTemplateNotifTemplSuccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *JobTemplateNotifTemplSuccessResource) Schema(ctx context.Context, req r
 	}
 }
 
+func (r *JobTemplateNotifTemplSuccessResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = jobTemplateNotificationIdentity.schema()
+}
+
 func (r *JobTemplateNotifTemplSuccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *JobTemplateNotifTemplSuccessResource) Create(ctx context.Context, req r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateNotifTemplSuccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *JobTemplateNotifTemplSuccessResource) Read(ctx context.Context, req res
 
 	url := fmt.Sprintf("job_templates/%d/notification_templates_success/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -162,6 +169,7 @@ func (r *JobTemplateNotifTemplSuccessResource) Read(ctx context.Context, req res
 	}
 	data.NotifTEmplateIDs = listValue
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateNotifTemplSuccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,7 +189,7 @@ func (r *JobTemplateNotifTemplSuccessResource) Update(ctx context.Context, req r
 
 	url := fmt.Sprintf("job_templates/%d/notification_templates_success/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -217,6 +225,7 @@ func (r *JobTemplateNotifTemplSuccessResource) Update(ctx context.Context, req r
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -240,6 +249,7 @@ func (r *JobTemplateNotifTemplSuccessResource) Update(ctx context.Context, req r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(jobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateNotifTemplSuccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -284,5 +294,10 @@ func (r *JobTemplateNotifTemplSuccessResource) Delete(ctx context.Context, req r
 }
 
 func (r *JobTemplateNotifTemplSuccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("job_template_id"), req, resp)
+	if req.ID == "" {
+		jobTemplateNotificationIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("job_template_id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &JobTemplateSurveyResource{}
 var _ resource.ResourceWithImportState = &JobTemplateSurveyResource{}
+var _ resource.ResourceWithIdentity = &JobTemplateSurveyResource{}
 
 func NewJobTemplateSurveyResource() resource.Resource {
 	return &JobTemplateSurveyResource{}
@@ -119,6 +120,10 @@ func (r *JobTemplateSurveyResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *JobTemplateSurveyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *JobTemplateSurveyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -217,6 +222,7 @@ func (r *JobTemplateSurveyResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateSurveyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -227,6 +233,11 @@ func (r *JobTemplateSurveyResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -427,6 +438,7 @@ func (r *JobTemplateSurveyResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *JobTemplateSurveyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -457,5 +469,10 @@ func (r *JobTemplateSurveyResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *JobTemplateSurveyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("job_templates", ""))
 }
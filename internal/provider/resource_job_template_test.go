@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -151,3 +154,45 @@ resource "%[1]s_job_template" "%[7]s" {
 }
   `, configprefix.Prefix, acctest.RandString(5), resource.Name, resource.Description, resource.JobType, resource.Playbook, rName)
 }
+
+func TestJobTemplateResource_offline(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	projectID := fake.seed("projects", map[string]any{"name": "playbooks", "organization": organizationID})
+	inventoryID := fake.seed("inventories", map[string]any{"name": "inventory", "organization": organizationID})
+
+	h := newResourceHarness[JobTemplateModel](t, NewJobTemplateResource, fake.client())
+
+	created := h.create(JobTemplateModel{
+		Id:        types.StringUnknown(),
+		Name:      types.StringValue("deploy"),
+		JobType:   types.StringValue("run"),
+		Inventory: types.Int32Value(int32(inventoryID)),
+		Project:   types.Int32Value(int32(projectID)),
+		Playbook:  types.StringValue("site.yml"),
+	})
+
+	if _, exists := h.read(created); !exists {
+		t.Fatal("expected the job template to exist after create")
+	}
+
+	// a missing project is reported against the project attribute
+	_, diags := h.tryCreate(JobTemplateModel{
+		Id:       types.StringUnknown(),
+		Name:     types.StringValue("broken"),
+		JobType:  types.StringValue("run"),
+		Project:  types.Int32Value(999),
+		Playbook: types.StringValue("site.yml"),
+	})
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected one error, got: %v", diags)
+	}
+	if attributeError, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || !attributeError.Path().Equal(path.Root("project")) {
+		t.Errorf("expected the error to point at the project attribute, got: %v", diags.Errors()[0])
+	}
+
+	h.delete(created)
+	if _, exists := h.read(created); exists {
+		t.Error("expected a deleted job template to be removed from state")
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 var _ resource.Resource = &LabelsResource{}
 var _ resource.ResourceWithImportState = &LabelsResource{}
+var _ resource.ResourceWithIdentity = &LabelsResource{}
 
 func NewLabelsResource() resource.Resource {
 	return &LabelsResource{}
@@ -54,6 +55,10 @@ func (r *LabelsResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *LabelsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *LabelsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,6 +114,7 @@ func (r *LabelsResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *LabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -120,6 +126,11 @@ func (r *LabelsResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -197,6 +208,7 @@ func (r *LabelsResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 // Left Intentionally blank, as there is no API endpoint to delete a label.
@@ -210,5 +222,10 @@ func (r *LabelsResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *LabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("labels", ""))
 }
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// A resource of the older community awx provider (registry.terraform.io/denouncq/awx and the forks it came from)
+// that state can be moved from with a `moved {}` block. Its resource types share names with this provider's, but
+// most reference attributes are named after the id they hold, i.e. `project_id` rather than `project`. Attributes
+// not in renames are copied when this provider has an attribute of the same name; any other attribute is
+// dropped, and the refresh that follows the move reads it from the controller. All of them are identified by id.
+type legacyResource struct {
+	typeName string
+	renames  map[string]string
+}
+
+var (
+	legacyOrganizationResource = legacyResource{
+		typeName: "awx_organization",
+	}
+	legacyProjectResource = legacyResource{
+		typeName: "awx_project",
+		renames:  map[string]string{"organization_id": "organization", "scm_credential_id": "credential"},
+	}
+	legacyInventoryResource = legacyResource{
+		typeName: "awx_inventory",
+		renames:  map[string]string{"organization_id": "organization"},
+	}
+	legacyHostResource = legacyResource{
+		typeName: "awx_host",
+		renames:  map[string]string{"inventory_id": "inventory"},
+	}
+	legacyCredentialResource = legacyResource{
+		typeName: "awx_credential",
+		renames:  map[string]string{"organization_id": "organization", "credential_type_id": "credential_type"},
+	}
+	legacyJobTemplateResource = legacyResource{
+		typeName: "awx_job_template",
+		renames:  map[string]string{"inventory_id": "inventory", "project_id": "project"},
+	}
+	legacyWorkflowJobTemplateResource = legacyResource{
+		typeName: "awx_workflow_job_template",
+		renames:  map[string]string{"organization_id": "organization", "inventory_id": "inventory", "variables": "extra_vars"},
+	}
+	legacyScheduleResource = legacyResource{
+		typeName: "awx_schedule",
+		renames:  map[string]string{"unified_job_template_id": "unified_job_template"},
+	}
+)
+
+// Whether address is the community awx provider rather than this one. Any namespace other than this provider's
+// is accepted, as the community provider has been published under several.
+func isLegacyProviderAddress(address string) bool {
+	parts := strings.Split(address, "/")
+	return len(parts) == 3 && parts[2] == "awx" && parts[1] != "tfbrew"
+}
+
+func (l legacyResource) stateMovers() []resource.StateMover {
+	return []resource.StateMover{{StateMover: l.moveState}}
+}
+
+// Translates the legacy resource's state into this provider's attribute layout.
+func (l legacyResource) moveState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != l.typeName || !isLegacyProviderAddress(req.SourceProviderAddress) {
+		return
+	}
+
+	if req.SourceRawState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to move state",
+			fmt.Sprintf("No state was given for the %s resource to move.", req.SourceTypeName))
+		return
+	}
+
+	// SDKv2 providers keep numbers as JSON numbers, which UseNumber keeps from being rounded through float64
+	decoder := json.NewDecoder(bytes.NewReader(req.SourceRawState.JSON))
+	decoder.UseNumber()
+
+	var sourceState map[string]any
+	if err := decoder.Decode(&sourceState); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal source state",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	for sourceName, sourceValue := range sourceState {
+		name, renamed := l.renames[sourceName]
+		if !renamed {
+			name = sourceName
+		}
+
+		attribute, ok := resp.TargetState.Schema.GetAttributes()[name]
+		if !ok {
+			continue
+		}
+
+		value, ok := legacyAttributeValue(ctx, attribute.GetType(), sourceValue, renamed)
+		if !ok {
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(name), value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.TargetState, resp.TargetIdentity)...)
+}
+
+// Converts a value of the legacy state to attrType. SDKv2 can't tell unset values apart from empty ones, so empty
+// strings are left null, as are the zero ids the legacy provider stores for unset references.
+func legacyAttributeValue(ctx context.Context, attrType attr.Type, value any, reference bool) (attr.Value, bool) {
+	var s string
+	switch value := value.(type) {
+	case string:
+		s = value
+	case json.Number:
+		s = value.String()
+	case bool:
+		s = strconv.FormatBool(value)
+	default:
+		return nil, false
+	}
+
+	if s == "" || (reference && s == "0") {
+		return nil, false
+	}
+
+	if stringType, ok := attrType.(basetypes.StringTypable); ok {
+		value, diags := stringType.ValueFromString(ctx, types.StringValue(s))
+		return value, !diags.HasError()
+	}
+
+	switch attrType {
+	case types.DynamicType:
+		return types.DynamicValue(types.StringValue(s)), true
+	case types.Int32Type:
+		i, err := strconv.ParseInt(s, 10, 32)
+		return types.Int32Value(int32(i)), err == nil
+	case types.Int64Type:
+		i, err := strconv.ParseInt(s, 10, 64)
+		return types.Int64Value(i), err == nil
+	case types.BoolType:
+		b, err := strconv.ParseBool(s)
+		return types.BoolValue(b), err == nil
+	}
+
+	return nil, false
+}
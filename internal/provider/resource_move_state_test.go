@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Runs the resource's state movers on the given source, the way the framework does for a `moved {}` block.
+func moveState(t *testing.T, r resource.ResourceWithMoveState, providerAddress, typeName, rawState string) (*resource.MoveStateResponse, bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	identityResp := &resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+	for _, mover := range r.MoveState(ctx) {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+			TargetIdentity: &tfsdk.ResourceIdentity{
+				Schema: identityResp.IdentitySchema,
+				Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+			},
+		}
+
+		mover.StateMover(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: providerAddress,
+			SourceTypeName:        typeName,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+		}, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if !resp.TargetState.Raw.IsNull() {
+			return resp, true
+		}
+	}
+
+	return nil, false
+}
+
+func TestMoveState_legacyJobTemplate(t *testing.T) {
+	resp, moved := moveState(t, &JobTemplateResource{}, "registry.terraform.io/denouncq/awx", "awx_job_template", `{
+		"id": "42",
+		"name": "deploy",
+		"description": "",
+		"inventory_id": "7",
+		"project_id": 3,
+		"playbook": "site.yml",
+		"forks": 5,
+		"become_enabled": true,
+		"instance_group_ids": [1, 2]
+	}`)
+	if !moved {
+		t.Fatal("expected the legacy job template to be moved")
+	}
+
+	var state JobTemplateModel
+	if diags := resp.TargetState.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("reading moved state: %v", diags)
+	}
+
+	if state.Id.ValueString() != "42" || state.Name.ValueString() != "deploy" || state.Playbook.ValueString() != "site.yml" {
+		t.Errorf("expected id, name and playbook to be copied, got %+v", state)
+	}
+	if state.Inventory.ValueInt32() != 7 || state.Project.ValueInt32() != 3 {
+		t.Errorf("expected inventory_id and project_id to be moved to inventory and project, got %v and %v", state.Inventory, state.Project)
+	}
+	if state.Forks.ValueInt32() != 5 || !state.BecomeEnabled.ValueBool() {
+		t.Errorf("expected forks and become_enabled to be copied, got %v and %v", state.Forks, state.BecomeEnabled)
+	}
+	if !state.Description.IsNull() {
+		t.Errorf("expected the empty description to be left null, got %v", state.Description)
+	}
+
+	var identityID types.Int64
+	if diags := resp.TargetIdentity.GetAttribute(context.Background(), path.Root("id"), &identityID); diags.HasError() || identityID.ValueInt64() != 42 {
+		t.Errorf("expected the identity to hold id 42, got %v (%v)", identityID, diags)
+	}
+}
+
+func TestMoveState_legacyWorkflowJobTemplate(t *testing.T) {
+	resp, moved := moveState(t, &WorkflowJobTemplatesResource{}, "registry.terraform.io/denouncq/awx", "awx_workflow_job_template", `{
+		"id": "9",
+		"name": "release",
+		"organization_id": 1,
+		"inventory_id": 0,
+		"variables": "---\nversion: 2\n"
+	}`)
+	if !moved {
+		t.Fatal("expected the legacy workflow job template to be moved")
+	}
+
+	var state WorkflowJobTemplatesResourceModel
+	if diags := resp.TargetState.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("reading moved state: %v", diags)
+	}
+
+	if state.Organization.ValueInt32() != 1 || state.ExtraVars.ValueString() != "---\nversion: 2\n" {
+		t.Errorf("expected organization_id and variables to be moved to organization and extra_vars, got %+v", state)
+	}
+	if !state.Inventory.IsNull() {
+		t.Errorf("expected the unset inventory_id to be left null, got %v", state.Inventory)
+	}
+}
+
+func TestMoveState_otherSources(t *testing.T) {
+	cases := []struct {
+		providerAddress string
+		typeName        string
+	}{
+		{"registry.terraform.io/tfbrew/awx", "awx_job_template"},
+		{"registry.terraform.io/denouncq/awx", "awx_project"},
+		{"registry.terraform.io/hashicorp/null", "awx_job_template"},
+	}
+	for _, c := range cases {
+		if _, moved := moveState(t, &JobTemplateResource{}, c.providerAddress, c.typeName, `{"id": "1", "name": "deploy"}`); moved {
+			t.Errorf("%s %s: expected no state mover to match", c.providerAddress, c.typeName)
+		}
+	}
+}
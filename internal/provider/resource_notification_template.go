@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &NotificationTemplatesResource{}
 var _ resource.ResourceWithImportState = &NotificationTemplatesResource{}
+var _ resource.ResourceWithIdentity = &NotificationTemplatesResource{}
 
 func NewNotificationTemplatesResource() resource.Resource {
 	return &NotificationTemplatesResource{}
@@ -78,6 +79,10 @@ func (r *NotificationTemplatesResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *NotificationTemplatesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *NotificationTemplatesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -171,6 +176,7 @@ func (r *NotificationTemplatesResource) Create(ctx context.Context, req resource
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationTemplatesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -182,6 +188,11 @@ func (r *NotificationTemplatesResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -606,6 +617,7 @@ func (r *NotificationTemplatesResource) Update(ctx context.Context, req resource
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationTemplatesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -636,5 +648,10 @@ func (r *NotificationTemplatesResource) Delete(ctx context.Context, req resource
 }
 
 func (r *NotificationTemplatesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("notification_templates", ""))
 }
@@ -16,11 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/tfbrew/terraform-provider-awx/internal/configprefix"
 )
 
 var _ resource.Resource = &OrganizationResource{}
 var _ resource.ResourceWithImportState = &OrganizationResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationResource{}
+var _ resource.ResourceWithIdentity = &OrganizationResource{}
+var _ resource.ResourceWithMoveState = &OrganizationResource{}
 
 func NewOrganizationResource() resource.Resource {
 	return &OrganizationResource{}
@@ -72,31 +74,36 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
-func (r OrganizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data OrganizationModel
+func (r *OrganizationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+func (r *OrganizationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyOrganizationResource.stateMovers()
+}
 
-	// Disallow default_environment for >AAP2.5
-	if configprefix.Prefix != "awx" && !data.DefaultEnv.IsNull() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("default_environment"),
-			"Invalid Attribute Configuration",
-			"Attribute default_environment is not supported in this version of the provider.",
-		)
+// default_environment and max_hosts aren't supported by the AAP 2.5+ gateway. This can't be checked in
+// ValidateConfig, as the platform is only known once the provider has been configured.
+func (r *OrganizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
 
-	// Disallow max_hosts for >AAP2.5 unless it's awx or unset/zero
-	if configprefix.Prefix != "awx" && !data.MaxHosts.IsNull() && data.MaxHosts.ValueInt32() != 0 {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("max_hosts"),
-			"Invalid Attribute Configuration",
-			"Attribute max_hosts is not supported in this version of the provider.",
-		)
+	var data OrganizationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !data.DefaultEnv.IsNull() {
+		r.client.requireCapability(&resp.Diagnostics, capabilityOrganizationDefaultEnvironment, path.Root("default_environment"))
+	}
+
+	// max_hosts defaults to 0, which every platform accepts
+	if !data.MaxHosts.IsNull() && data.MaxHosts.ValueInt32() != 0 {
+		r.client.requireCapability(&resp.Diagnostics, capabilityOrganizationMaxHosts, path.Root("max_hosts"))
+	}
 }
 
 func (r *OrganizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -161,12 +168,12 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	data.Aap25GatewayId = types.Int32Value(int32(id))
 
-	if configprefix.Prefix == "aap" {
+	if r.client.platform == platformAAP {
 
 		// overwrite returnedData with Get against org's /controller/ endpoint
 
 		url := fmt.Sprintf("organizations/?name=%s", data.Name.ValueString())
-		responseBodyData, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "controller")
+		responseBodyData, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "controller")
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error making API http request",
@@ -195,6 +202,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -205,6 +213,11 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -238,10 +251,10 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// if aap2.5 get the /gateway/ id and set the related field
-	if configprefix.Prefix == "aap" {
+	if r.client.platform == platformAAP {
 
 		url := fmt.Sprintf("organizations/?name=%s", responseData.Name)
-		responseBodyData, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "gateway")
+		responseBodyData, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "gateway")
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error making API http request",
@@ -319,7 +332,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	var id int
 	var err error
 
-	if configprefix.Prefix == "aap" {
+	if r.client.platform == platformAAP {
 		id = int(data.Aap25GatewayId.ValueInt32())
 	} else {
 		id, err = strconv.Atoi(data.Id.ValueString())
@@ -357,6 +370,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -370,7 +384,7 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 	var id int
 	var err error
 
-	if configprefix.Prefix == "aap" {
+	if r.client.platform == platformAAP {
 		id = int(data.Aap25GatewayId.ValueInt32())
 	} else {
 		id, err = strconv.Atoi(data.Id.ValueString())
@@ -394,5 +408,10 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationImportKey)
 }
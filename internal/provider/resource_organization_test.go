@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -170,3 +171,32 @@ resource "%[1]s_organization" "test" {
 }
   `, configprefix.Prefix, resource.Name, resource.Description)
 }
+
+func TestOrganizationResource_offline(t *testing.T) {
+	fake := newFakeController(t)
+
+	h := newResourceHarness[OrganizationModel](t, NewOrganizationResource, fake.client())
+
+	created := h.create(OrganizationModel{
+		Id:             types.StringUnknown(),
+		Aap25GatewayId: types.Int32Unknown(),
+		Name:           types.StringValue("engineering"),
+		Description:    types.StringValue("first"),
+		MaxHosts:       types.Int32Value(0),
+	})
+
+	updated := created
+	updated.Description = types.StringValue("second")
+	updated.MaxHosts = types.Int32Value(50)
+	updated = h.update(created, updated)
+
+	imported := h.importState(created.Id.ValueString())
+	if imported.Description.ValueString() != "second" || imported.MaxHosts.ValueInt32() != 50 {
+		t.Errorf("unexpected imported organization: %+v", imported)
+	}
+
+	h.delete(updated)
+	if fake.get("organizations", 1) != nil {
+		t.Error("expected the organization to be deleted")
+	}
+}
@@ -8,20 +8,40 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithIdentity = &ProjectResource{}
+var _ resource.ResourceWithMoveState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+
+// How long deleting a project keeps retrying while the controller refuses because the project is in use, i.e. by
+// its first sync, when the timeouts block doesn't say.
+const defaultProjectDeleteTimeout = 5 * time.Minute
+
+// How long to wait between attempts to delete a project in use. A variable so tests can shorten it.
+var projectDeleteRetryInterval = 4 * time.Second
+
+var projectAPIFieldPaths = apiFieldPaths(ProjectModel{})
+
+// The attributes whose change makes the controller sync the project.
+var projectSourceAttributes = []string{"scm_type", "scm_url", "scm_branch", "scm_refspec", "credential"}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -139,12 +159,51 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:    true,
 				Default:     int32default.StaticInt32(0),
 			},
+			"wait_for_sync": schema.BoolAttribute{
+				Description: "Whether to wait for the source control sync the controller starts when the project is created or its source changes, and fail when the sync does not succeed. Set it when job templates are created alongside the project, so their playbooks are found. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"scm_revision": schema.StringAttribute{
+				Description: "The source control revision the project last synced. Null until the next refresh when the source changes without `wait_for_sync`, as the sync hasn't run yet.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"playbooks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "The playbooks found in the project by its last successful sync. Null until the next refresh when the source changes without `wait_for_sync`, as the sync hasn't run yet.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "How long creating the project may take, including its sync when `wait_for_sync` is set, as a duration such as `\"30m\"`. Defaults to `\"20m\"`.",
+				UpdateDescription: "How long updating the project may take, including its sync when `wait_for_sync` is set, as a duration such as `\"30m\"`. Defaults to `\"20m\"`.",
+				DeleteDescription: "How long deleting the project may keep retrying while the project is in use, i.e. by a sync or a job, as a duration such as `\"10m\"`. Defaults to `\"5m\"`.",
+			}),
 		},
 	}
 }
 
+func (r *ProjectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *ProjectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyProjectResource.stateMovers()
+}
+
 func (r ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data ProjectModel
+	var data ProjectResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -298,6 +357,44 @@ func (r ProjectResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 }
 
+// scm_revision and playbooks keep their prior values, as only a sync changes them. An update that changes the
+// project's source makes the controller sync it, so they are only known after such an update.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	sourceChanged, diags := projectSourceChanged(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !sourceChanged {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scm_revision"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("playbooks"), types.ListUnknown(types.StringType))...)
+}
+
+// Whether plan changes any of projectSourceAttributes from state. A value that's unknown until apply counts as a
+// change.
+func projectSourceChanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, name := range projectSourceAttributes {
+		var planned, prior attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &prior)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if !planned.Equal(prior) {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
 func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -317,13 +414,22 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ProjectModel
+	var data ProjectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var bodyData ProjectAPIModel
 
 	bodyData.Name = data.Name.ValueString()
@@ -379,9 +485,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	url := "projects/"
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API http request", err, projectAPIFieldPaths)
 		return
 	}
 
@@ -399,17 +503,27 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	data.LocalPath = types.StringValue(fmt.Sprintf("%v", returnedData["local_path"]))
 	data.ScmUrl = types.StringValue(fmt.Sprintf("%v", returnedData["scm_url"]))
 
+	// a sync that didn't succeed is reported after saving the project, which taints it
+	syncDiags := r.setSyncResult(ctx, &data, true)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(syncDiags...)
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ProjectModel
+	var data ProjectResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -458,6 +572,22 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scm_update_on_launch"), responseData.ScmUpdOnLaunch)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scm_update_cache_timeout"), responseData.ScmUpdateCacheTimeout)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), responseData.Timeout)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scm_revision"), responseData.ScmRevision)...)
+
+	// the playbooks are informational, so failing to list them keeps the ones read before
+	playbooks, err := r.readPlaybooks(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read playbooks",
+			fmt.Sprintf("Keeping the playbooks read before. Error was: %s.", err.Error()))
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("playbooks"), playbooks)...)
+	}
+
+	// imported projects have no setting yet
+	if data.WaitForSync.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_sync"), false)...)
+	}
 
 	if !data.Description.IsNull() || responseData.Description != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), responseData.Description)...)
@@ -510,13 +640,22 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProjectModel
+	var data ProjectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -525,6 +664,12 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	sourceChanged, diags := projectSourceChanged(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var bodyData ProjectAPIModel
 
 	bodyData.Name = data.Name.ValueString()
@@ -580,9 +725,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	url := fmt.Sprintf("projects/%d/", id)
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200}, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error making API update request", err, projectAPIFieldPaths)
 		return
 	}
 
@@ -599,17 +742,116 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	data.LocalPath = types.StringValue(fmt.Sprintf("%v", returnedData["local_path"]))
 	data.ScmUrl = types.StringValue(fmt.Sprintf("%v", returnedData["scm_url"]))
 
+	syncDiags := r.setSyncResult(ctx, &data, sourceChanged)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(syncDiags...)
+}
+
+// Waits for the project's sync when wait_for_sync is set, then sets scm_revision and playbooks when sourceChanged,
+// which is when the plan left them unknown. Create passes true, as the controller syncs every new project. Unless
+// the source changed, only a sync that's already running is waited for. Returns an error when the sync doesn't
+// succeed, leaving the attributes as the project's last successful sync left them, or when the wait is given up
+// on, leaving them unset.
+func (r *ProjectResource) setSyncResult(ctx context.Context, data *ProjectResourceModel, sourceChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return diags
+	}
+
+	// left unset when the project can't be read, i.e. after giving up on its sync, or when nothing waited for the
+	// sync, in which case they're filled in by the next refresh
+	if sourceChanged {
+		data.ScmRevision = types.StringNull()
+		data.Playbooks = types.ListNull(types.StringType)
+	}
+
+	if data.WaitForSync.ValueBool() {
+		update, err := r.client.waitForProjectSync(ctx, id, sourceChanged)
+		if err != nil {
+			diags.AddError(
+				"Error waiting for project sync",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return diags
+		}
+		if update != nil && update.Status != "successful" {
+			diags.AddError(
+				"Project sync did not succeed",
+				r.client.projectSyncFailureDescription(ctx, *update))
+		}
+	}
+
+	if !sourceChanged || !data.WaitForSync.ValueBool() {
+		return diags
+	}
+
+	url := fmt.Sprintf("projects/%d/", id)
+	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	if err != nil {
+		diags.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return diags
+	}
+
+	var responseData ProjectAPIModel
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		diags.AddError(
+			"Unable to unmarshal json",
+			fmt.Sprintf("bodyData: %+v.", body))
+		return diags
+	}
+	data.ScmRevision = types.StringValue(responseData.ScmRevision)
+
+	playbooks, err := r.readPlaybooks(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Error reading playbooks",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return diags
+	}
+	data.Playbooks = playbooks
+
+	return diags
+}
+
+func (r *ProjectResource) readPlaybooks(ctx context.Context, id int) (types.List, error) {
+	playbooks, err := r.client.projectPlaybooks(ctx, id)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, playbooks)
+	if diags.HasError() {
+		return types.ListNull(types.StringType), fmt.Errorf("unable to convert playbooks: %v", diags)
+	}
+
+	return list, nil
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ProjectModel
+	var data ProjectResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultProjectDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -621,36 +863,48 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	url := fmt.Sprintf("projects/%d/", id)
 	// 403 & 409 return code indicates project is being used by a job or had sync failures.
 	// Newly create projects will be syncing and not able to be deleted immediately.
-	attempts := 30
-	var del_err error
-	var statusCode int
-	for attempt := 0; attempt < attempts; attempt++ {
-		_, statusCode, del_err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204}, "")
-		if del_err == nil {
-			if attempt > 0 {
+	var inUseErr error
+	for attempt := 1; ; attempt++ {
+		_, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204}, "")
+		if err == nil {
+			if attempt > 1 {
 				resp.Diagnostics.AddWarning(
 					"Retry required to delete project",
 					fmt.Sprintf("Project was successfully delete after %d attempt(s).", attempt))
 			}
 			return
 		}
+		// the timeout can also run out during a retry, when the controller's last refusal says more than the
+		// deadline does
+		if ctx.Err() != nil && inUseErr != nil {
+			resp.Diagnostics.AddError(
+				"Error making API delete request",
+				fmt.Sprintf("Error after %v attempt(s) was: %s.", attempt-1, inUseErr.Error()))
+			return
+		}
 		if statusCode != 403 && statusCode != 409 {
 			resp.Diagnostics.AddError(
 				"Error making API delete request",
-				fmt.Sprintf("Error was: %s.", del_err.Error()))
+				fmt.Sprintf("Error was: %s.", err.Error()))
 			return
 		}
-		time.Sleep(4 * time.Second)
-	}
+		inUseErr = err
 
-	if del_err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API delete request",
-			fmt.Sprintf("Error after %v attempt(s) was: %s.", attempts, del_err.Error()))
-		return
+		SleepWithContext(ctx, projectDeleteRetryInterval)
+		if ctx.Err() != nil {
+			resp.Diagnostics.AddError(
+				"Error making API delete request",
+				fmt.Sprintf("Error after %v attempt(s) was: %s.", attempt, err.Error()))
+			return
+		}
 	}
 }
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("projects", ""))
 }
@@ -2,8 +2,14 @@ package provider
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -295,3 +301,204 @@ resource "%[1]s_project" "%[10]s" {
 }
   `, configprefix.Prefix, acctest.RandString(5), resource.Name, resource.Description, resource.ScmType, resource.ScmUrl, resource.ScmUpdOnLaunch, resource.ScmUpdateCacheTimeout, resource.Timeout, rName)
 }
+
+func plannedProject(organizationID int64, scmType string, waitForSync bool) ProjectResourceModel {
+	return ProjectResourceModel{
+		ProjectModel: ProjectModel{
+			Id:                    types.StringUnknown(),
+			Name:                  types.StringValue("playbooks"),
+			Organization:          types.Int32Value(int32(organizationID)),
+			ScmType:               types.StringValue(scmType),
+			AllowOverride:         types.BoolValue(false),
+			LocalPath:             types.StringUnknown(),
+			ScmClean:              types.BoolValue(false),
+			ScmDelOnUpdate:        types.BoolValue(false),
+			ScmTrackSubmodules:    types.BoolValue(false),
+			ScmUpdOnLaunch:        types.BoolValue(false),
+			ScmUpdateCacheTimeout: types.Int32Value(0),
+			ScmUrl:                types.StringValue("https://github.com/example/playbooks.git"),
+			Timeout:               types.Int32Value(0),
+			ScmRevision:           types.StringUnknown(),
+			Playbooks:             types.ListUnknown(types.StringType),
+		},
+		WaitForSync: types.BoolValue(waitForSync),
+		Timeouts:    resourceTimeouts(nil),
+	}
+}
+
+// Has each sync of a project write stdout and leave the project at revision with playbooks.
+func syncFakeProjectsTo(fake *fakeController, stdout, revision string, playbooks ...any) {
+	fake.onLaunch = func(jobCollection string, jobID int64) {
+		if jobCollection != "project_updates" {
+			return
+		}
+		fake.objects[jobCollection][jobID]["stdout"] = stdout
+
+		project := fake.objects["projects"][fake.objects[jobCollection][jobID]["project"].(int64)]
+		project["scm_revision"] = revision
+		project["playbooks"] = playbooks
+	}
+}
+
+func TestProjectResource_waitForSync(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	syncFakeProjectsTo(fake, "", "3f2a9c1", "site.yml", "deploy.yml")
+
+	h := newResourceHarness[ProjectResourceModel](t, NewProjectResource, fake.client())
+
+	created := h.create(plannedProject(organizationID, "git", true))
+	if created.ScmRevision.ValueString() != "3f2a9c1" {
+		t.Errorf("expected the revision of the finished sync, got %v", created.ScmRevision)
+	}
+
+	var playbooks []string
+	if diags := created.Playbooks.ElementsAs(t.Context(), &playbooks, false); diags.HasError() || !slices.Equal(playbooks, []string{"site.yml", "deploy.yml"}) {
+		t.Errorf("expected the synced playbooks, got %v %v", playbooks, diags)
+	}
+
+	updates := fake.allObjects("project_updates")
+	if len(updates) != 1 || !slices.Contains(fake.requestLog(), fmt.Sprintf("GET /api/v2/project_updates/%d/", updates[0]["id"])) {
+		t.Errorf("expected create to wait for the project's sync, got %v", fake.requestLog())
+	}
+
+	// changing the source starts another sync, which is waited for too
+	syncFakeProjectsTo(fake, "", "8b41e07", "site.yml")
+	planned := created
+	planned.ScmBranch = types.StringValue("release")
+	planned.ScmRevision = types.StringUnknown()
+	planned.Playbooks = types.ListUnknown(types.StringType)
+	updated := h.update(created, planned)
+	if updated.ScmRevision.ValueString() != "8b41e07" || len(updated.Playbooks.Elements()) != 1 {
+		t.Errorf("expected the revision and playbooks of the new sync, got %+v", updated)
+	}
+
+	// other changes don't sync the project, so its finished sync isn't waited for again
+	requests := len(fake.requestLog())
+	planned = updated
+	planned.Description = types.StringValue("playbooks for the web servers")
+	described := h.update(updated, planned)
+	if described.ScmRevision != updated.ScmRevision || !described.Playbooks.Equal(updated.Playbooks) {
+		t.Errorf("expected the revision and playbooks to be kept, got %+v", described)
+	}
+	if slices.ContainsFunc(fake.requestLog()[requests:], func(request string) bool { return strings.Contains(request, "project_updates/") }) {
+		t.Errorf("expected the update not to wait for a sync, got %v", fake.requestLog()[requests:])
+	}
+
+	imported := h.importState(created.Id.ValueString())
+	if imported.WaitForSync.ValueBool() || imported.ScmRevision != updated.ScmRevision || !imported.Playbooks.Equal(updated.Playbooks) {
+		t.Errorf("unexpected imported project: %+v", imported)
+	}
+
+	// a refresh that can't list the playbooks keeps the ones it had
+	projectID, _ := strconv.ParseInt(created.Id.ValueString(), 10, 64)
+	fake.objects["projects"][projectID]["playbooks"] = "not a list"
+	refreshed, _ := h.read(described)
+	if !refreshed.Playbooks.Equal(described.Playbooks) {
+		t.Errorf("expected the playbooks to be kept, got %v", refreshed.Playbooks)
+	}
+}
+
+func TestProjectResource_withoutWaitForSync(t *testing.T) {
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	syncFakeProjectsTo(fake, "", "3f2a9c1", "site.yml")
+
+	h := newResourceHarness[ProjectResourceModel](t, NewProjectResource, fake.client())
+
+	// nothing waited for the sync, so whatever the controller reports now predates it
+	created := h.create(plannedProject(organizationID, "git", false))
+	if !created.ScmRevision.IsNull() || !created.Playbooks.IsNull() {
+		t.Errorf("expected the revision and playbooks to be left for the next refresh, got %v %v", created.ScmRevision, created.Playbooks)
+	}
+
+	refreshed, _ := h.read(created)
+	if refreshed.ScmRevision.ValueString() != "3f2a9c1" || len(refreshed.Playbooks.Elements()) != 1 {
+		t.Errorf("expected the refresh to read the revision and playbooks, got %v %v", refreshed.ScmRevision, refreshed.Playbooks)
+	}
+}
+
+func TestProjectResource_modifyPlan(t *testing.T) {
+	h := newResourceHarness[ProjectResourceModel](t, NewProjectResource, nil)
+
+	current := plannedProject(1, "git", true)
+	current.Id = types.StringValue("7")
+	current.LocalPath = types.StringValue("_7__playbooks")
+	current.ScmRevision = types.StringValue("3f2a9c1")
+	current.Playbooks = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("site.yml")})
+
+	// the plan modifiers have already kept the prior values
+	planned := current
+	planned.Description = types.StringValue("playbooks for the web servers")
+	if modified := h.modifyPlan(current, planned); modified.ScmRevision != current.ScmRevision || !modified.Playbooks.Equal(current.Playbooks) {
+		t.Errorf("expected an update that doesn't sync to keep the revision and playbooks, got %+v", modified)
+	}
+
+	planned = current
+	planned.ScmBranch = types.StringValue("release")
+	if modified := h.modifyPlan(current, planned); !modified.ScmRevision.IsUnknown() || !modified.Playbooks.IsUnknown() {
+		t.Errorf("expected a source change to leave the revision and playbooks to the sync, got %+v", modified)
+	}
+}
+
+func TestProjectResource_failedSync(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+	syncFakeProjectsTo(fake, "Cloning into playbooks...\nfatal: repository not found\n", "")
+	fake.setJobOutcome(map[string]any{"status": "failed", "failed": true})
+
+	h := newResourceHarness[ProjectResourceModel](t, NewProjectResource, fake.client())
+
+	_, diags := h.tryCreate(plannedProject(organizationID, "git", true))
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "fatal: repository not found") {
+		t.Errorf("expected the failed sync to fail the apply with its output, got %v", diags)
+	}
+	if projects := fake.allObjects("projects"); len(projects) != 1 {
+		t.Errorf("expected the project to be created regardless, got %v", projects)
+	}
+
+	// without wait_for_sync the failure is left for whatever uses the project to find
+	planned := plannedProject(organizationID, "git", false)
+	planned.Name = types.StringValue("unchecked")
+	if _, diags := h.tryCreate(planned); diags.HasError() {
+		t.Errorf("expected the project to be created without waiting, got %v", diags)
+	}
+}
+
+func TestProjectResource_deleteInUse(t *testing.T) {
+	fastJobPolling(t)
+	interval := projectDeleteRetryInterval
+	projectDeleteRetryInterval = 10 * time.Millisecond
+	t.Cleanup(func() { projectDeleteRetryInterval = interval })
+
+	fake := newFakeController(t)
+	organizationID := fake.seed("organizations", map[string]any{"name": "Default"})
+
+	h := newResourceHarness[ProjectResourceModel](t, NewProjectResource, fake.client())
+
+	// nothing waits for the sync, which the controller refuses to delete the project during
+	created := h.create(plannedProject(organizationID, "git", false))
+	created.Timeouts = resourceTimeouts(map[string]string{"delete": "100ms"})
+
+	diags := h.tryDelete(created)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "being used by running jobs") {
+		t.Errorf("expected the delete to give up at its timeout, got %v", diags)
+	}
+	if deleteAttempts := len(slices.DeleteFunc(fake.requestLog(), func(request string) bool { return !strings.HasPrefix(request, "DELETE ") })); deleteAttempts < 2 {
+		t.Errorf("expected the delete to be retried until the timeout, got %d attempt(s)", deleteAttempts)
+	}
+
+	// once the sync has finished the project can go
+	update := fake.allObjects("project_updates")[0]
+	if _, err := fake.client().waitForUnifiedJob(t.Context(), fmt.Sprintf("project_updates/%d/", update["id"]), nil); err != nil {
+		t.Fatalf("waiting for the sync: %v", err)
+	}
+	h.delete(created)
+	if _, exists := h.read(created); exists {
+		t.Error("expected the deleted project to be removed from state")
+	}
+}
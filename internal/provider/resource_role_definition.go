@@ -17,6 +17,8 @@ import (
 
 var _ resource.Resource = &RoleDefinitionResource{}
 var _ resource.ResourceWithImportState = &RoleDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &RoleDefinitionResource{}
+var _ resource.ResourceWithIdentity = &RoleDefinitionResource{}
 
 func NewRoleDefinitionResource() resource.Resource {
 	return &RoleDefinitionResource{}
@@ -62,6 +64,18 @@ func (r *RoleDefinitionResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *RoleDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *RoleDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.client.requireCapability(&resp.Diagnostics, capabilityRoleAssignments, path.Empty())
+}
+
 func (r *RoleDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -117,6 +131,7 @@ func (r *RoleDefinitionResource) Create(ctx context.Context, req resource.Create
 	data.Id = types.StringValue(fmt.Sprintf("%v", idVal))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoleDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,6 +142,11 @@ func (r *RoleDefinitionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -206,6 +226,7 @@ func (r *RoleDefinitionResource) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoleDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -235,5 +256,10 @@ func (r *RoleDefinitionResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *RoleDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, nameImportKey("role_definitions", ""))
 }
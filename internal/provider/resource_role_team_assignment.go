@@ -18,6 +18,8 @@ import (
 
 var _ resource.Resource = &RoleTeamAssignmentResource{}
 var _ resource.ResourceWithImportState = &RoleTeamAssignmentResource{}
+var _ resource.ResourceWithModifyPlan = &RoleTeamAssignmentResource{}
+var _ resource.ResourceWithIdentity = &RoleTeamAssignmentResource{}
 
 func NewRoleTeamAssignmentResource() resource.Resource {
 	return &RoleTeamAssignmentResource{}
@@ -67,6 +69,18 @@ func (r *RoleTeamAssignmentResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+func (r *RoleTeamAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *RoleTeamAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.client.requireCapability(&resp.Diagnostics, capabilityRoleAssignments, path.Empty())
+}
+
 func (r *RoleTeamAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -115,6 +129,7 @@ func (r *RoleTeamAssignmentResource) Create(ctx context.Context, req resource.Cr
 	data.Id = types.StringValue(fmt.Sprintf("%v", idVal))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoleTeamAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -124,6 +139,11 @@ func (r *RoleTeamAssignmentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -189,5 +209,10 @@ func (r *RoleTeamAssignmentResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *RoleTeamAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -18,6 +18,8 @@ import (
 
 var _ resource.Resource = &RoleUserAssignmentResource{}
 var _ resource.ResourceWithImportState = &RoleUserAssignmentResource{}
+var _ resource.ResourceWithModifyPlan = &RoleUserAssignmentResource{}
+var _ resource.ResourceWithIdentity = &RoleUserAssignmentResource{}
 
 func NewRoleUserAssignmentResource() resource.Resource {
 	return &RoleUserAssignmentResource{}
@@ -67,6 +69,18 @@ func (r *RoleUserAssignmentResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+func (r *RoleUserAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *RoleUserAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.client.requireCapability(&resp.Diagnostics, capabilityRoleAssignments, path.Empty())
+}
+
 func (r *RoleUserAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -115,6 +129,7 @@ func (r *RoleUserAssignmentResource) Create(ctx context.Context, req resource.Cr
 	data.Id = types.StringValue(fmt.Sprintf("%v", idVal))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoleUserAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -124,6 +139,11 @@ func (r *RoleUserAssignmentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -189,5 +209,10 @@ func (r *RoleUserAssignmentResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *RoleUserAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// How long creating, updating or deleting an object may take when its timeouts block doesn't say. The controller
+// answers most requests right away, so this mostly bounds the retries of the ones it is too busy to answer.
+const defaultResourceTimeout = 20 * time.Minute
+
+// The timeouts block of a resource whose create, update and delete are bounded by defaultResourceTimeout. object
+// names what the resource manages, i.e. "the inventory".
+func resourceTimeoutsBlock(ctx context.Context, object string) schema.Block {
+	description := func(operation string) string {
+		return fmt.Sprintf("How long %s %s may take, including retries of requests the controller is too busy to answer, as a duration such as `\"5m\"`. Defaults to `\"20m\"`.", operation, object)
+	}
+
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("creating"),
+		UpdateDescription: description("updating"),
+		DeleteDescription: description("deleting"),
+	})
+}
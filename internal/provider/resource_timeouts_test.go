@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The timeouts block of a resource made with resourceTimeoutsBlock, with the given timeouts set by operation, i.e.
+// {"delete": "1s"}. Null when none are given.
+func resourceTimeouts(values map[string]string) timeouts.Value {
+	attributeTypes := map[string]attr.Type{"create": types.StringType, "update": types.StringType, "delete": types.StringType}
+	if len(values) == 0 {
+		return timeouts.Value{Object: types.ObjectNull(attributeTypes)}
+	}
+
+	attributes := map[string]attr.Value{}
+	for name := range attributeTypes {
+		attributes[name] = types.StringNull()
+		if value, ok := values[name]; ok {
+			attributes[name] = types.StringValue(value)
+		}
+	}
+
+	return timeouts.Value{Object: types.ObjectValueMust(attributeTypes, attributes)}
+}
+
+func TestResourceTimeouts_boundRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	client := &providerClient{
+		client:                   server.Client(),
+		endpoint:                 server.URL,
+		auth:                     "Bearer " + fakeControllerToken,
+		apiRetryCount:            5,
+		apiRetryDelaySeconds:     1,
+		apiRetryMutatingRequests: true,
+	}
+	client.setPlatform(platformAWX)
+
+	h := newResourceHarness[InventoryResourceModel](t, NewInventoryResource, client)
+
+	// five retries a second apart would take five seconds, if not for the create timeout
+	started := time.Now()
+	_, diags := h.tryCreate(InventoryResourceModel{
+		InventoryModel: InventoryModel{
+			Id:           types.StringUnknown(),
+			Name:         types.StringValue("inventory"),
+			Organization: types.Int32Value(1),
+		},
+		Timeouts: resourceTimeouts(map[string]string{"create": "200ms"}),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected the create to fail")
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Errorf("expected the create to give up at its timeout, took %s", elapsed)
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The `variables_map` and `extra_vars_map` attributes hold variables as a native HCL value rather than a JSON or
+// YAML string. They're sent to the controller JSON encoded, and read back by decoding whatever document the
+// controller returns, the way credential inputs are.
+
+// Converts a document decoded by parseVariables into a dynamic value: mappings become objects and sequences
+// tuples, so each element keeps its own type.
+func variablesApiToDynamicObject(document any, dynValue *basetypes.DynamicValue) diag.Diagnostics {
+	value, diags := variablesApiToValue(document)
+	if diags.HasError() {
+		return diags
+	}
+
+	*dynValue = types.DynamicValue(value)
+
+	return diags
+}
+
+func variablesApiToValue(document any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch document := document.(type) {
+	case nil:
+		return types.StringNull(), diags
+	case string:
+		return types.StringValue(document), diags
+	case bool:
+		return types.BoolValue(document), diags
+	case float64:
+		return types.NumberValue(big.NewFloat(document)), diags
+	case []any:
+		elementTypes := make([]attr.Type, len(document))
+		elements := make([]attr.Value, len(document))
+		for i, element := range document {
+			value, elementDiags := variablesApiToValue(element)
+			diags.Append(elementDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elementTypes[i] = value.Type(context.Background())
+			elements[i] = value
+		}
+
+		tuple, tupleDiags := types.TupleValue(elementTypes, elements)
+		diags.Append(tupleDiags...)
+		return tuple, diags
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(document))
+		attributes := make(map[string]attr.Value, len(document))
+		for key, element := range document {
+			value, elementDiags := variablesApiToValue(element)
+			diags.Append(elementDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			attributeTypes[key] = value.Type(context.Background())
+			attributes[key] = value
+		}
+
+		object, objectDiags := types.ObjectValue(attributeTypes, attributes)
+		diags.Append(objectDiags...)
+		return object, diags
+	}
+
+	diags.AddError(
+		"Unexpected Variables Type",
+		fmt.Sprintf("Variables hold a value of unexpected type: %T", document),
+	)
+	return nil, diags
+}
+
+// JSON encodes a `variables_map` value for the controller.
+func variablesMapToString(variablesMap types.Dynamic) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	document, err := variablesValueToAny(variablesMap)
+	if err != nil {
+		diags.AddError(
+			"Unable to encode variables",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return "", diags
+	}
+
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		diags.AddError(
+			"Unable to encode variables",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return "", diags
+	}
+
+	return string(encoded), diags
+}
+
+func variablesValueToAny(value attr.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("variables hold a value that is not known yet")
+	}
+
+	switch value := value.(type) {
+	case types.Dynamic:
+		return variablesValueToAny(value.UnderlyingValue())
+	case types.String:
+		return value.ValueString(), nil
+	case types.Bool:
+		return value.ValueBool(), nil
+	case types.Number:
+		return json.Number(value.ValueBigFloat().Text('g', -1)), nil
+	case types.Int64:
+		return value.ValueInt64(), nil
+	case types.Float64:
+		return value.ValueFloat64(), nil
+	case types.Object:
+		return variablesElementsToAny(value.Attributes())
+	case types.Map:
+		return variablesElementsToAny(value.Elements())
+	case types.Tuple:
+		return variablesListToAny(value.Elements())
+	case types.List:
+		return variablesListToAny(value.Elements())
+	case types.Set:
+		return variablesListToAny(value.Elements())
+	}
+
+	return nil, fmt.Errorf("variables hold a value of unexpected type: %T", value)
+}
+
+func variablesElementsToAny(elements map[string]attr.Value) (any, error) {
+	document := make(map[string]any, len(elements))
+	for key, value := range elements {
+		element, err := variablesValueToAny(value)
+		if err != nil {
+			return nil, err
+		}
+		document[key] = element
+	}
+
+	return document, nil
+}
+
+func variablesListToAny(elements []attr.Value) (any, error) {
+	document := make([]any, len(elements))
+	for i, element := range elements {
+		value, err := variablesValueToAny(element)
+		if err != nil {
+			return nil, err
+		}
+		document[i] = value
+	}
+
+	return document, nil
+}
+
+// The value of a `variables_map` attribute after a Read. The prior value is kept while it holds the same document
+// as the controller's variables, so its types stay the way they were configured; otherwise the controller's
+// variables are decoded into a new value.
+func variablesMapFromAPI(prior types.Dynamic, apiVariables string) (types.Dynamic, diag.Diagnostics) {
+	priorVariables, diags := variablesMapToString(prior)
+	if !diags.HasError() && variablesEqual(priorVariables, apiVariables) {
+		return prior, diags
+	}
+
+	return variablesStringToDynamic(apiVariables)
+}
+
+// Decodes the controller's variables, a JSON or YAML document, into a dynamic value.
+func variablesStringToDynamic(apiVariables string) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	document, err := parseVariables(apiVariables)
+	if err != nil {
+		diags.AddError(
+			"Unable to decode variables",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return types.DynamicNull(), diags
+	}
+
+	var value basetypes.DynamicValue
+	diags.Append(variablesApiToDynamicObject(document, &value)...)
+
+	return value, diags
+}
@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &WorkflowJobLaunchResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobLaunchResource{}
+
+var workflowJobLaunchNodeAttrTypes = map[string]attr.Type{
+	"node_id":  types.Int64Type,
+	"name":     types.StringType,
+	"job_id":   types.Int64Type,
+	"job_type": types.StringType,
+	"status":   types.StringType,
+}
+
+func NewWorkflowJobLaunchResource() resource.Resource {
+	return &WorkflowJobLaunchResource{}
+}
+
+type WorkflowJobLaunchResource struct {
+	client *providerClient
+}
+
+func (r *WorkflowJobLaunchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_job_launch"
+}
+
+func (r *WorkflowJobLaunchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Launches a workflow job template and waits for the workflow job to finish, failing the apply when it fails. Changing any argument other than `wait_for_completion`, `pending_approvals` and `timeouts`, such as a value in `triggers`, launches a new workflow job. Destroying the resource only removes it from state; the workflow job stays in the controller's history.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the launched workflow job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_job_template": schema.Int32Attribute{
+				Description: "ID of the workflow job template to launch.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"extra_vars": schema.StringAttribute{
+				CustomType:  VariablesStringType{},
+				Description: "Extra variables for the workflow job, in JSON or YAML format. The workflow job template must prompt for variables on launch, or enable its survey.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inventory": schema.Int32Attribute{
+				Description: "ID of the inventory to run the workflow's jobs against. The workflow job template must prompt for the inventory on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"limit": schema.StringAttribute{
+				Description: "Host pattern limiting the hosts the workflow's jobs run against. The workflow job template must prompt for the limit on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scm_branch": schema.StringAttribute{
+				Description: "Branch the workflow's jobs use. The workflow job template must prompt for the branch on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_tags": schema.StringAttribute{
+				Description: "Comma separated tags to run. The workflow job template must prompt for tags on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_tags": schema.StringAttribute{
+				Description: "Comma separated tags to skip. The workflow job template must prompt for skip tags on launch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Arbitrary values that launch a new workflow job when any of them changes, i.e. `{ release = var.release }`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the workflow job to finish, and fail when it does not succeed. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"pending_approvals": schema.StringAttribute{
+				Description: "What to do when the workflow reaches an approval node while waiting for it: `fail` stops waiting and fails the apply, leaving the approval pending; `wait` keeps waiting for someone to approve or deny it, or for it to time out; `approve` approves it. Defaults to `fail`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fail"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"fail", "wait", "approve"}...),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the workflow job, i.e. `successful`, as of the last refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"elapsed": schema.Float64Attribute{
+				Description: "Seconds the workflow job ran for.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "The workflow job's nodes, ordered by id, with the job each of them ran.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_id": schema.Int64Attribute{
+							Description: "ID of the workflow job node.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the template the node runs.",
+							Computed:    true,
+						},
+						"job_id": schema.Int64Attribute{
+							Description: "ID of the job the node ran; null when the node did not run.",
+							Computed:    true,
+						},
+						"job_type": schema.StringAttribute{
+							Description: "Type of the job the node ran, i.e. `job`, `project_update` or `workflow_approval`; null when the node did not run.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the job the node ran; null when the node did not run.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the workflow job to finish, as a duration such as `\"30m\"` or `\"2h\"`. Defaults to `\"60m\"`.",
+			}),
+		},
+	}
+}
+
+func (r *WorkflowJobLaunchResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *WorkflowJobLaunchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = configureData
+}
+
+func (r *WorkflowJobLaunchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowJobLaunchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultJobLaunchTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var bodyData WorkflowJobLaunchAPIModel
+	bodyData.ExtraVars = data.ExtraVars.ValueString()
+	bodyData.Inventory = int(data.Inventory.ValueInt32())
+	bodyData.Limit = data.Limit.ValueString()
+	bodyData.ScmBranch = data.ScmBranch.ValueString()
+	bodyData.JobTags = data.JobTags.ValueString()
+	bodyData.SkipTags = data.SkipTags.ValueString()
+
+	url := fmt.Sprintf("workflow_job_templates/%d/launch/", data.WorkflowJobTemplate.ValueInt32())
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201}, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error launching workflow job template",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	workflowJobID, ok := returnedData["workflow_job"].(float64)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error retrieving computed values",
+			"Could not retrieve workflow_job.")
+		return
+	}
+
+	warnIgnoredLaunchFields(&resp.Diagnostics, returnedData)
+
+	data.Id = types.StringValue(strconv.Itoa(int(workflowJobID)))
+
+	jobURL := fmt.Sprintf("workflow_jobs/%d/", int(workflowJobID))
+	var job unifiedJobAPIModel
+	if data.WaitForCompletion.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+
+		job, err = r.client.waitForUnifiedJob(waitCtx, jobURL, func(job unifiedJobAPIModel) error {
+			return r.handlePendingApprovals(waitCtx, job, data.PendingApprovals.ValueString())
+		})
+	} else {
+		job, _, err = r.client.getUnifiedJob(ctx, jobURL)
+	}
+
+	nodes, nodesDiags := r.setWorkflowJobLaunchResult(ctx, &data, job)
+	resp.Diagnostics.Append(nodesDiags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+
+	// the workflow job was launched, so it's kept in state, where an error taints it to be launched again
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for workflow job",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() && job.Status != "successful" {
+		resp.Diagnostics.AddError(
+			"Workflow job did not succeed",
+			workflowJobFailureDescription(job, nodes))
+	}
+}
+
+func (r *WorkflowJobLaunchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowJobLaunchModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+
+	job, statusCode, err := r.client.getUnifiedJob(ctx, fmt.Sprintf("workflow_jobs/%d/", id))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	// Cleaning up old jobs is routine, and shouldn't launch the workflow again, so the last known result is kept.
+	if statusCode == 404 {
+		return
+	}
+
+	_, diags := r.setWorkflowJobLaunchResult(ctx, &data, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only wait_for_completion, pending_approvals and timeouts can change without launching a new workflow job, and
+// none of them needs the API.
+func (r *WorkflowJobLaunchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkflowJobLaunchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
+}
+
+// Jobs can't be undone, so destroying the resource leaves the workflow job in the controller's history.
+func (r *WorkflowJobLaunchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Acts on the approval nodes a running workflow job is waiting on, as pending_approvals says.
+func (r *WorkflowJobLaunchResource) handlePendingApprovals(ctx context.Context, job unifiedJobAPIModel, action string) error {
+	if action == "wait" {
+		return nil
+	}
+
+	nodes, err := r.client.listWorkflowJobNodes(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if !node.pendingApproval() {
+			continue
+		}
+
+		if action != "approve" {
+			return fmt.Errorf("workflow job %d is waiting for approval %q (%d), and pending_approvals is %q", job.Id, node.SummaryFields.Job.Name, *node.Job, action)
+		}
+
+		url := fmt.Sprintf("workflow_approvals/%d/approve/", *node.Job)
+		if _, _, err := r.client.GenericAPIRequest(ctx, http.MethodPost, url, nil, []int{204}, ""); err != nil {
+			return fmt.Errorf("unable to approve %q (%d): %w", node.SummaryFields.Job.Name, *node.Job, err)
+		}
+	}
+
+	return nil
+}
+
+// Sets the computed attributes from the workflow job and its nodes, and returns the nodes.
+func (r *WorkflowJobLaunchResource) setWorkflowJobLaunchResult(ctx context.Context, data *WorkflowJobLaunchModel, job unifiedJobAPIModel) ([]workflowJobNodeAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data.Status = types.StringValue(job.Status)
+	data.Elapsed = types.Float64Value(job.Elapsed)
+	data.Nodes = types.ListNull(types.ObjectType{AttrTypes: workflowJobLaunchNodeAttrTypes})
+
+	id, _ := strconv.Atoi(data.Id.ValueString())
+	nodes, err := r.client.listWorkflowJobNodes(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Error listing workflow job nodes",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return nil, diags
+	}
+
+	nodeModels := make([]WorkflowJobLaunchNodeModel, 0, len(nodes))
+	for _, node := range nodes {
+		nodeModel := WorkflowJobLaunchNodeModel{
+			NodeId:  types.Int64Value(int64(node.Id)),
+			Name:    types.StringValue(node.SummaryFields.UnifiedJobTemplate.Name),
+			JobId:   types.Int64Null(),
+			JobType: types.StringNull(),
+			Status:  types.StringNull(),
+		}
+		if node.Job != nil {
+			nodeModel.JobId = types.Int64Value(int64(*node.Job))
+			nodeModel.JobType = types.StringValue(node.SummaryFields.Job.Type)
+			nodeModel.Status = types.StringValue(node.SummaryFields.Job.Status)
+		}
+		nodeModels = append(nodeModels, nodeModel)
+	}
+
+	data.Nodes, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workflowJobLaunchNodeAttrTypes}, nodeModels)
+
+	return nodes, diags
+}
+
+// Describes why a finished workflow job didn't succeed, naming the nodes whose jobs didn't either.
+func workflowJobFailureDescription(job unifiedJobAPIModel, nodes []workflowJobNodeAPIModel) string {
+	var failedNodes []string
+	for _, node := range nodes {
+		if node.Job != nil && slices.Contains([]string{"failed", "error", "canceled"}, node.SummaryFields.Job.Status) {
+			failedNodes = append(failedNodes, fmt.Sprintf("%s (job %d, %s)", node.SummaryFields.Job.Name, *node.Job, node.SummaryFields.Job.Status))
+		}
+	}
+
+	description := job.failureDescription()
+	if len(failedNodes) > 0 {
+		description += " Nodes that did not succeed: " + strings.Join(failedNodes, ", ") + "."
+	}
+
+	return description
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func plannedWorkflowJobLaunch(workflowJobTemplateID int64, pendingApprovals string) WorkflowJobLaunchModel {
+	return WorkflowJobLaunchModel{
+		Id:                  types.StringUnknown(),
+		WorkflowJobTemplate: types.Int32Value(int32(workflowJobTemplateID)),
+		ExtraVars:           NewVariablesStringNull(),
+		Inventory:           types.Int32Null(),
+		Limit:               types.StringNull(),
+		ScmBranch:           types.StringNull(),
+		JobTags:             types.StringNull(),
+		SkipTags:            types.StringNull(),
+		Triggers:            types.MapNull(types.StringType),
+		WaitForCompletion:   types.BoolValue(true),
+		PendingApprovals:    types.StringValue(pendingApprovals),
+		Status:              types.StringUnknown(),
+		Elapsed:             types.Float64Unknown(),
+		Nodes:               types.ListUnknown(types.ObjectType{AttrTypes: workflowJobLaunchNodeAttrTypes}),
+		Timeouts:            jobLaunchTimeouts(""),
+	}
+}
+
+// Adds a node to a workflow job, running a job of jobType with status, or nothing when jobType is empty.
+func insertFakeWorkflowNode(f *fakeController, workflowJobID int64, name, jobType, status string) {
+	node := map[string]any{
+		"workflow_job": workflowJobID,
+		"job":          nil,
+		"do_not_run":   jobType == "",
+	}
+	summaryFields := map[string]any{"unified_job_template": map[string]any{"name": name}}
+
+	if jobType != "" {
+		jobID := f.insert(jobType+"s", map[string]any{"name": name, "status": status, "workflow_job": workflowJobID})
+		node["job"] = jobID
+		summaryFields["job"] = map[string]any{"id": jobID, "name": name, "type": jobType, "status": status}
+	}
+
+	nodeID := f.insert("workflow_nodes", node)
+	f.objects["workflow_nodes"][nodeID]["summary_fields"] = summaryFields
+}
+
+func TestWorkflowJobLaunchResource_offline(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	workflowJobTemplateID := fake.seed("workflow_job_templates", map[string]any{"name": "release"})
+	fake.onLaunch = func(jobCollection string, jobID int64) {
+		insertFakeWorkflowNode(fake, jobID, "deploy", "job", "successful")
+		insertFakeWorkflowNode(fake, jobID, "rollback", "", "")
+	}
+
+	h := newResourceHarness[WorkflowJobLaunchModel](t, NewWorkflowJobLaunchResource, fake.client())
+
+	planned := plannedWorkflowJobLaunch(workflowJobTemplateID, "fail")
+	planned.Limit = types.StringValue("web*")
+	created := h.create(planned)
+	if created.Status.ValueString() != "successful" {
+		t.Errorf("expected the finished workflow job's status, got %v", created.Status)
+	}
+
+	var nodes []WorkflowJobLaunchNodeModel
+	if diags := created.Nodes.ElementsAs(t.Context(), &nodes, false); diags.HasError() {
+		t.Fatalf("reading nodes: %v", diags)
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("expected both nodes, got %+v", nodes)
+	}
+	if nodes[0].Name.ValueString() != "deploy" || nodes[0].JobType.ValueString() != "job" || nodes[0].Status.ValueString() != "successful" || nodes[0].JobId.IsNull() {
+		t.Errorf("expected the node that ran to report its job, got %+v", nodes[0])
+	}
+	if nodes[1].Name.ValueString() != "rollback" || !nodes[1].JobId.IsNull() || !nodes[1].Status.IsNull() {
+		t.Errorf("expected the node that didn't run to have no job, got %+v", nodes[1])
+	}
+
+	refreshed, exists := h.read(created)
+	if !exists || !refreshed.Nodes.Equal(created.Nodes) {
+		t.Errorf("expected a refresh to keep the nodes, got %+v", refreshed)
+	}
+}
+
+func TestWorkflowJobLaunchResource_failedWorkflow(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	workflowJobTemplateID := fake.seed("workflow_job_templates", map[string]any{"name": "release"})
+	fake.setJobOutcome(map[string]any{"status": "failed", "failed": true})
+	fake.onLaunch = func(jobCollection string, jobID int64) {
+		insertFakeWorkflowNode(fake, jobID, "deploy", "job", "failed")
+	}
+
+	h := newResourceHarness[WorkflowJobLaunchModel](t, NewWorkflowJobLaunchResource, fake.client())
+
+	_, diags := h.tryCreate(plannedWorkflowJobLaunch(workflowJobTemplateID, "fail"))
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "deploy (job ") {
+		t.Errorf("expected the failed workflow job to fail the apply naming the failed node, got %v", diags)
+	}
+}
+
+func TestWorkflowJobLaunchResource_pendingApprovals(t *testing.T) {
+	fastJobPolling(t)
+
+	fake := newFakeController(t)
+	workflowJobTemplateID := fake.seed("workflow_job_templates", map[string]any{"name": "release"})
+	fake.setJobOutcome(map[string]any{"status": "running"})
+	fake.onLaunch = func(jobCollection string, jobID int64) {
+		insertFakeWorkflowNode(fake, jobID, "go live?", "workflow_approval", "pending")
+	}
+
+	h := newResourceHarness[WorkflowJobLaunchModel](t, NewWorkflowJobLaunchResource, fake.client())
+
+	_, diags := h.tryCreate(plannedWorkflowJobLaunch(workflowJobTemplateID, "fail"))
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), `waiting for approval "go live?"`) {
+		t.Errorf("expected the pending approval to fail the apply, got %v", diags)
+	}
+
+	created := h.create(plannedWorkflowJobLaunch(workflowJobTemplateID, "approve"))
+	if created.Status.ValueString() != "successful" {
+		t.Errorf("expected the approved workflow job to succeed, got %v", created.Status)
+	}
+
+	approved := 0
+	for _, approval := range fake.allObjects("workflow_approvals") {
+		if approval["status"] == "successful" {
+			approved++
+		}
+	}
+	if approved != 1 {
+		t.Errorf("expected only the second workflow job's approval to be approved, got %d", approved)
+	}
+}
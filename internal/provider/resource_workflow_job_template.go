@@ -19,6 +19,8 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplatesResource{}
+var _ resource.ResourceWithMoveState = &WorkflowJobTemplatesResource{}
 
 func NewWorkflowJobTemplateResource() resource.Resource {
 	return &WorkflowJobTemplatesResource{}
@@ -50,9 +52,10 @@ func (r *WorkflowJobTemplatesResource) Schema(ctx context.Context, req resource.
 				Optional: true,
 			},
 			"extra_vars": schema.StringAttribute{
-				Optional: true,
-				Default:  stringdefault.StaticString("---"),
-				Computed: true,
+				CustomType: VariablesStringType{},
+				Optional:   true,
+				Default:    stringdefault.StaticString("---"),
+				Computed:   true,
 			},
 			"organization": schema.Int32Attribute{
 				Required: true,
@@ -144,6 +147,14 @@ func (r *WorkflowJobTemplatesResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *WorkflowJobTemplatesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
+func (r *WorkflowJobTemplatesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return legacyWorkflowJobTemplateResource.stateMovers()
+}
+
 func (r *WorkflowJobTemplatesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -257,6 +268,7 @@ func (r *WorkflowJobTemplatesResource) Create(ctx context.Context, req resource.
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -268,6 +280,11 @@ func (r *WorkflowJobTemplatesResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -479,6 +496,7 @@ func (r *WorkflowJobTemplatesResource) Update(ctx context.Context, req resource.
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -507,5 +525,10 @@ func (r *WorkflowJobTemplatesResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *WorkflowJobTemplatesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("workflow_job_templates", ""))
 }
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplateApprovalNode{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplateApprovalNode{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplateApprovalNode{}
 
 func NewWorkflowJobTemplateApprovalNodeResource() resource.Resource {
 	return &WorkflowJobTemplateApprovalNode{}
@@ -89,6 +90,10 @@ func (r *WorkflowJobTemplateApprovalNode) Schema(ctx context.Context, req resour
 	}
 }
 
+func (r *WorkflowJobTemplateApprovalNode) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *WorkflowJobTemplateApprovalNode) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -203,6 +208,7 @@ func (r *WorkflowJobTemplateApprovalNode) Create(ctx context.Context, req resour
 	data.ApprovalTemplateId = types.Int32Value(int32(tempIdInt))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateApprovalNode) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -214,6 +220,11 @@ func (r *WorkflowJobTemplateApprovalNode) Read(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	/// read the node's workflow template ID first
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
@@ -347,6 +358,7 @@ func (r *WorkflowJobTemplateApprovalNode) Update(ctx context.Context, req resour
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateApprovalNode) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -376,5 +388,10 @@ func (r *WorkflowJobTemplateApprovalNode) Delete(ctx context.Context, req resour
 }
 
 func (r *WorkflowJobTemplateApprovalNode) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -22,6 +22,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesJobNodeResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesJobNodeResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplatesJobNodeResource{}
 
 func NewWorkflowJobTemplateJobNodeResource() resource.Resource {
 	return &WorkflowJobTemplatesJobNodeResource{}
@@ -148,6 +149,10 @@ func (r *WorkflowJobTemplatesJobNodeResource) Schema(ctx context.Context, req re
 	}
 }
 
+func (r *WorkflowJobTemplatesJobNodeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *WorkflowJobTemplatesJobNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -250,6 +255,7 @@ func (r *WorkflowJobTemplatesJobNodeResource) Create(ctx context.Context, req re
 	data.Identifier = types.StringValue(fmt.Sprintf("%v", returnedData["identifier"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesJobNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -261,6 +267,11 @@ func (r *WorkflowJobTemplatesJobNodeResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -506,6 +517,7 @@ func (r *WorkflowJobTemplatesJobNodeResource) Update(ctx context.Context, req re
 	data.Identifier = types.StringValue(fmt.Sprintf("%v", returnedData["identifier"]))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesJobNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -535,5 +547,10 @@ func (r *WorkflowJobTemplatesJobNodeResource) Delete(ctx context.Context, req re
 }
 
 func (r *WorkflowJobTemplatesJobNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
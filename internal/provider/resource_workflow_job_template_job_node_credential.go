@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplateJobNodeCredentialResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplateJobNodeCredentialResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplateJobNodeCredentialResource{}
 
 func NewWorkflowJobTemplateJobNodeCredentialResource() resource.Resource {
 	return &WorkflowJobTemplateJobNodeCredentialResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplateJobNodeCredentialResourceModel struct {
 
 func (r *WorkflowJobTemplateJobNodeCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_job_node_credential"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplateJobNodeCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Schema(ctx context.Contex
 	}
 }
 
+func (r *WorkflowJobTemplateJobNodeCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowNodeCredentialIdentity.schema()
+}
+
 func (r *WorkflowJobTemplateJobNodeCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Create(ctx context.Contex
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeCredentialIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateJobNodeCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Read(ctx context.Context,
 
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/credentials/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -162,6 +169,7 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Read(ctx context.Context,
 	}
 	data.CredentialIds = listValue
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeCredentialIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateJobNodeCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,7 +189,7 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Update(ctx context.Contex
 
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/credentials/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -217,6 +225,7 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Update(ctx context.Contex
 		if !slices.Contains(PlanCredIds, v) {
 			var bodyData CredentialDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -239,6 +248,7 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Update(ctx context.Contex
 		}
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeCredentialIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateJobNodeCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -284,5 +294,10 @@ func (r *WorkflowJobTemplateJobNodeCredentialResource) Delete(ctx context.Contex
 }
 
 func (r *WorkflowJobTemplateJobNodeCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		workflowNodeCredentialIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesNodeAlwaysResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesNodeAlwaysResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplatesNodeAlwaysResource{}
 
 func NewWorkflowJobTemplateNodeAlwaysResource() resource.Resource {
 	return &WorkflowJobTemplatesNodeAlwaysResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplatesNodeAlwaysResourceModel struct {
 
 func (r *WorkflowJobTemplatesNodeAlwaysResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_node_always"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplatesNodeAlwaysResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -52,6 +54,10 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Schema(ctx context.Context, req
 	}
 }
 
+func (r *WorkflowJobTemplatesNodeAlwaysResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowNodeAlwaysIdentity.schema()
+}
+
 func (r *WorkflowJobTemplatesNodeAlwaysResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -110,6 +116,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Create(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeAlwaysIdentity.set(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -131,7 +138,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Read(ctx context.Context, req r
 	}
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/always_nodes/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -167,6 +174,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Read(ctx context.Context, req r
 	data.AlwaysIds = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeAlwaysIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -186,7 +194,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req
 
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/always_nodes/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -222,6 +230,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -246,6 +255,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeAlwaysIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeAlwaysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -289,5 +299,10 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Delete(ctx context.Context, req
 }
 
 func (r *WorkflowJobTemplatesNodeAlwaysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		workflowNodeAlwaysIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesNodeFailureResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesNodeFailureResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplatesNodeFailureResource{}
 
 func NewWorkflowJobTemplateNodeFailureResource() resource.Resource {
 	return &WorkflowJobTemplatesNodeFailureResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplatesNodeFailureResourceModel struct {
 
 func (r *WorkflowJobTemplatesNodeFailureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_node_failure"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplatesNodeFailureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -52,6 +54,10 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Schema(ctx context.Context, re
 	}
 }
 
+func (r *WorkflowJobTemplatesNodeFailureResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowNodeFailureIdentity.schema()
+}
+
 func (r *WorkflowJobTemplatesNodeFailureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,6 +115,7 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Create(ctx context.Context, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeFailureIdentity.set(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -130,7 +137,7 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Read(ctx context.Context, req
 	}
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/failure_nodes/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -166,6 +173,7 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Read(ctx context.Context, req
 	data.FailureIds = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeFailureIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeFailureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -185,7 +193,7 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Update(ctx context.Context, re
 
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/failure_nodes/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -221,6 +229,7 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Update(ctx context.Context, re
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -245,6 +254,7 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Update(ctx context.Context, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeFailureIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeFailureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -288,5 +298,10 @@ func (r *WorkflowJobTemplatesNodeFailureResource) Delete(ctx context.Context, re
 }
 
 func (r *WorkflowJobTemplatesNodeFailureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		workflowNodeFailureIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
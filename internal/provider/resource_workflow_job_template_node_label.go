@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesNodeLabelResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesNodeLabelResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplatesNodeLabelResource{}
 
 func NewWorkflowJobTemplateNodeLabelResource() resource.Resource {
 	return &WorkflowJobTemplatesNodeLabelResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplatesNodeLabelResourceModel struct {
 
 func (r *WorkflowJobTemplatesNodeLabelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_node_label"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplatesNodeLabelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -52,6 +54,10 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Schema(ctx context.Context, req
 	}
 }
 
+func (r *WorkflowJobTemplatesNodeLabelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowNodeLabelIdentity.schema()
+}
+
 func (r *WorkflowJobTemplatesNodeLabelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,6 +115,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Create(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeLabelIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -129,7 +136,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Read(ctx context.Context, req re
 	}
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/labels/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -165,6 +172,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Read(ctx context.Context, req re
 	data.LabelIDs = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeLabelIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -184,7 +192,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req
 
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/labels/", id)
 
-	responseBody, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	responseBody, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -220,6 +228,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -243,6 +252,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeLabelIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -286,5 +296,10 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Delete(ctx context.Context, req
 }
 
 func (r *WorkflowJobTemplatesNodeLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		workflowNodeLabelIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
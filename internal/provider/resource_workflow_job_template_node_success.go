@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesNodeSuccessResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesNodeSuccessResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplatesNodeSuccessResource{}
 
 func NewWorkflowJobTemplateNodeSuccessResource() resource.Resource {
 	return &WorkflowJobTemplatesNodeSuccessResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplatesNodeSuccessResourceModel struct {
 
 func (r *WorkflowJobTemplatesNodeSuccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_node_success"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplatesNodeSuccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -52,6 +54,10 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Schema(ctx context.Context, re
 	}
 }
 
+func (r *WorkflowJobTemplatesNodeSuccessResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowNodeSuccessIdentity.schema()
+}
+
 func (r *WorkflowJobTemplatesNodeSuccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,6 +115,7 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Create(ctx context.Context, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeSuccessIdentity.set(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -130,7 +137,7 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Read(ctx context.Context, req
 	}
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/success_nodes/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -166,6 +173,7 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Read(ctx context.Context, req
 	data.SuccessIds = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeSuccessIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeSuccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -185,7 +193,7 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Update(ctx context.Context, re
 
 	url := fmt.Sprintf("workflow_job_template_nodes/%d/success_nodes/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -221,6 +229,7 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Update(ctx context.Context, re
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -245,6 +254,7 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Update(ctx context.Context, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowNodeSuccessIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplatesNodeSuccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -288,5 +298,10 @@ func (r *WorkflowJobTemplatesNodeSuccessResource) Delete(ctx context.Context, re
 }
 
 func (r *WorkflowJobTemplatesNodeSuccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		workflowNodeSuccessIdentity.importState(ctx, req, resp)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplateNotifTemplErrorResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplateNotifTemplErrorResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplateNotifTemplErrorResource{}
 
 func NewWorkflowJobTemplateNotifTemplErrorResource() resource.Resource {
 	return &WorkflowJobTemplateNotifTemplErrorResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplateNotifTemplErrorResourceModel struct {
 
 func (r *WorkflowJobTemplateNotifTemplErrorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_notification_template_error"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplateNotifTemplErrorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Schema(ctx context.Context,
 	}
 }
 
+func (r *WorkflowJobTemplateNotifTemplErrorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowJobTemplateNotificationIdentity.schema()
+}
+
 func (r *WorkflowJobTemplateNotifTemplErrorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Create(ctx context.Context,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowJobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateNotifTemplErrorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Read(ctx context.Context, r
 
 	url := fmt.Sprintf("workflow_job_templates/%d/notification_templates_error/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -163,6 +170,7 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Read(ctx context.Context, r
 	data.NotifTEmplateIDs = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowJobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateNotifTemplErrorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -182,7 +190,7 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Update(ctx context.Context,
 
 	url := fmt.Sprintf("workflow_job_templates/%d/notification_templates_error/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -218,6 +226,7 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Update(ctx context.Context,
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -241,6 +250,7 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Update(ctx context.Context,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowJobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateNotifTemplErrorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -285,5 +295,10 @@ func (r *WorkflowJobTemplateNotifTemplErrorResource) Delete(ctx context.Context,
 }
 
 func (r *WorkflowJobTemplateNotifTemplErrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("workflow_job_template_id"), req, resp)
+	if req.ID == "" {
+		workflowJobTemplateNotificationIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("workflow_job_template_id"), req, resp, organizationScopedImportKey("workflow_job_templates", ""))
 }
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplateNotifTemplSuccessResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplateNotifTemplSuccessResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplateNotifTemplSuccessResource{}
 
 func NewWorkflowJobTemplateNotifTemplSuccessResource() resource.Resource {
 	return &WorkflowJobTemplateNotifTemplSuccessResource{}
@@ -32,6 +33,7 @@ type WorkflowJobTemplateNotifTemplSuccessResourceModel struct {
 
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow_job_template_notification_template_success"
+	resp.ResourceBehavior = resource.ResourceBehavior{MutableIdentity: true}
 }
 
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +53,10 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Schema(ctx context.Contex
 	}
 }
 
+func (r *WorkflowJobTemplateNotifTemplSuccessResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workflowJobTemplateNotificationIdentity.schema()
+}
+
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -108,6 +114,7 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Create(ctx context.Contex
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowJobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,7 +134,7 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Read(ctx context.Context,
 
 	url := fmt.Sprintf("workflow_job_templates/%d/notification_templates_success/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404}, "")
+	body, statusCode, err := r.client.ListAPIRequest(ctx, url, []int{200, 404}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -163,6 +170,7 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Read(ctx context.Context,
 	data.NotifTEmplateIDs = listValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowJobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -182,7 +190,7 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Update(ctx context.Contex
 
 	url := fmt.Sprintf("workflow_job_templates/%d/notification_templates_success/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200}, "")
+	body, _, err := r.client.ListAPIRequest(ctx, url, []int{200}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -218,6 +226,7 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Update(ctx context.Contex
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204}, "")
 			if err != nil {
@@ -241,6 +250,7 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Update(ctx context.Contex
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workflowJobTemplateNotificationIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -285,5 +295,10 @@ func (r *WorkflowJobTemplateNotifTemplSuccessResource) Delete(ctx context.Contex
 }
 
 func (r *WorkflowJobTemplateNotifTemplSuccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("workflow_job_template_id"), req, resp)
+	if req.ID == "" {
+		workflowJobTemplateNotificationIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("workflow_job_template_id"), req, resp, organizationScopedImportKey("workflow_job_templates", ""))
 }
@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplateSurveyResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplateSurveyResource{}
+var _ resource.ResourceWithIdentity = &WorkflowJobTemplateSurveyResource{}
 
 func NewWorkflowJobTemplateSurveyResource() resource.Resource {
 	return &WorkflowJobTemplateSurveyResource{}
@@ -119,6 +120,10 @@ func (r *WorkflowJobTemplateSurveyResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *WorkflowJobTemplateSurveyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentity.schema()
+}
+
 func (r *WorkflowJobTemplateSurveyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -217,6 +222,7 @@ func (r *WorkflowJobTemplateSurveyResource) Create(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateSurveyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -227,6 +233,11 @@ func (r *WorkflowJobTemplateSurveyResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	resp.Diagnostics.Append(idIdentity.set(ctx, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -427,6 +438,7 @@ func (r *WorkflowJobTemplateSurveyResource) Update(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(idIdentity.set(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WorkflowJobTemplateSurveyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -457,5 +469,10 @@ func (r *WorkflowJobTemplateSurveyResource) Delete(ctx context.Context, req reso
 }
 
 func (r *WorkflowJobTemplateSurveyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		idIdentity.importState(ctx, req, resp)
+		return
+	}
+
+	importStateByNaturalKey(ctx, r.client, path.Root("id"), req, resp, organizationScopedImportKey("workflow_job_templates", ""))
 }
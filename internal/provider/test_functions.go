@@ -166,6 +166,18 @@ func importStateWorkflowJobTemplateID(resourceName string) resource.ImportStateI
 	}
 }
 
+// ImportStateIdFunc to build the group_id/host_id import id of a group_host resource.
+func importStateGroupHostID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["group_id"], rs.Primary.Attributes["host_id"]), nil
+	}
+}
+
 // panic if can't convert to string.
 func mustMarshal(v any) string {
 	b, err := json.Marshal(v)
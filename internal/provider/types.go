@@ -1,6 +1,8 @@
 package provider
 
 import (
+	actiontimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -139,12 +141,13 @@ type ExecutionEnvironmentAPIModel struct {
 }
 
 type HostModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	Inventory   types.Int32  `tfsdk:"inventory"`
-	Variables   types.String `tfsdk:"variables"`
+	Id           types.String    `tfsdk:"id"`
+	Name         types.String    `tfsdk:"name"`
+	Description  types.String    `tfsdk:"description"`
+	Enabled      types.Bool      `tfsdk:"enabled"`
+	Inventory    types.Int32     `tfsdk:"inventory"`
+	Variables    VariablesString `tfsdk:"variables"`
+	VariablesMap types.Dynamic   `tfsdk:"variables_map"`
 }
 
 type HostAPIModel struct {
@@ -157,11 +160,12 @@ type HostAPIModel struct {
 }
 
 type GroupModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Inventory   types.Int32  `tfsdk:"inventory"`
-	Variables   types.String `tfsdk:"variables"`
+	Id           types.String    `tfsdk:"id"`
+	Name         types.String    `tfsdk:"name"`
+	Description  types.String    `tfsdk:"description"`
+	Inventory    types.Int32     `tfsdk:"inventory"`
+	Variables    VariablesString `tfsdk:"variables"`
+	VariablesMap types.Dynamic   `tfsdk:"variables_map"`
 }
 
 type GroupAPIModel struct {
@@ -182,15 +186,21 @@ type GroupHostAssocAPIModel struct {
 }
 
 type InstanceGroupModel struct {
-	Id                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	IsContainerGroup         types.Bool   `tfsdk:"is_container_group"`
-	MaxConcurrentJobs        types.Int32  `tfsdk:"max_concurrent_jobs"`
-	MaxForks                 types.Int32  `tfsdk:"max_forks"`
-	PodSpecOverride          types.String `tfsdk:"pod_spec_override"`
-	PolicyInstancePercentage types.Int32  `tfsdk:"policy_instance_percentage"`
-	PolicyInstanceMinimum    types.Int32  `tfsdk:"policy_instance_minimum"`
-	Credential               types.Int32  `tfsdk:"credential"`
+	Id                       types.String    `tfsdk:"id"`
+	Name                     types.String    `tfsdk:"name"`
+	IsContainerGroup         types.Bool      `tfsdk:"is_container_group"`
+	MaxConcurrentJobs        types.Int32     `tfsdk:"max_concurrent_jobs"`
+	MaxForks                 types.Int32     `tfsdk:"max_forks"`
+	PodSpecOverride          VariablesString `tfsdk:"pod_spec_override"`
+	PolicyInstancePercentage types.Int32     `tfsdk:"policy_instance_percentage"`
+	PolicyInstanceMinimum    types.Int32     `tfsdk:"policy_instance_minimum"`
+	Credential               types.Int32     `tfsdk:"credential"`
+}
+
+// The instance group resource's model, adding the settings of the resource itself to the instance group's fields.
+type InstanceGroupResourceModel struct {
+	InstanceGroupModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type InstanceGroupAPIModel struct {
@@ -206,13 +216,20 @@ type InstanceGroupAPIModel struct {
 }
 
 type InventoryModel struct {
-	Id           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	Organization types.Int32  `tfsdk:"organization"`
-	Variables    types.String `tfsdk:"variables"`
-	Kind         types.String `tfsdk:"kind"`
-	HostFilter   types.String `tfsdk:"host_filter"`
+	Id           types.String    `tfsdk:"id"`
+	Name         types.String    `tfsdk:"name"`
+	Description  types.String    `tfsdk:"description"`
+	Organization types.Int32     `tfsdk:"organization"`
+	Variables    VariablesString `tfsdk:"variables"`
+	VariablesMap types.Dynamic   `tfsdk:"variables_map"`
+	Kind         types.String    `tfsdk:"kind"`
+	HostFilter   types.String    `tfsdk:"host_filter"`
+}
+
+// The inventory resource's model, adding the settings of the resource itself to the inventory's fields.
+type InventoryResourceModel struct {
+	InventoryModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type InventoryAPIModel struct {
@@ -226,25 +243,32 @@ type InventoryAPIModel struct {
 }
 
 type InventorySourceModel struct {
-	Id                   types.String `tfsdk:"id"`
-	Name                 types.String `tfsdk:"name"`
-	Inventory            types.Int32  `tfsdk:"inventory"`
-	Source               types.String `tfsdk:"source"`
-	Credential           types.Int32  `tfsdk:"credential"`
-	Description          types.String `tfsdk:"description"`
-	ExecutionEnvironment types.Int32  `tfsdk:"execution_environment"`
-	SourcePath           types.String `tfsdk:"source_path"`
-	EnabledValue         types.String `tfsdk:"enabled_value"`
-	EnabledVar           types.String `tfsdk:"enabled_var"`
-	HostFilter           types.String `tfsdk:"host_filter"`
-	OverwriteVars        types.Bool   `tfsdk:"overwrite_vars"`
-	Overwrite            types.Bool   `tfsdk:"overwrite"`
-	SourceVars           types.String `tfsdk:"source_vars"`
-	SourceProject        types.Int32  `tfsdk:"source_project"`
-	ScmBranch            types.String `tfsdk:"scm_branch"`
-	UpdateCacheTimeout   types.Int32  `tfsdk:"update_cache_timeout"`
-	UpdateOnLaunch       types.Bool   `tfsdk:"update_on_launch"`
-	Verbosity            types.Int32  `tfsdk:"verbosity"`
+	Id                   types.String    `tfsdk:"id"`
+	Name                 types.String    `tfsdk:"name"`
+	Inventory            types.Int32     `tfsdk:"inventory"`
+	Source               types.String    `tfsdk:"source"`
+	Credential           types.Int32     `tfsdk:"credential"`
+	Description          types.String    `tfsdk:"description"`
+	ExecutionEnvironment types.Int32     `tfsdk:"execution_environment"`
+	SourcePath           types.String    `tfsdk:"source_path"`
+	EnabledValue         types.String    `tfsdk:"enabled_value"`
+	EnabledVar           types.String    `tfsdk:"enabled_var"`
+	HostFilter           types.String    `tfsdk:"host_filter"`
+	OverwriteVars        types.Bool      `tfsdk:"overwrite_vars"`
+	Overwrite            types.Bool      `tfsdk:"overwrite"`
+	SourceVars           VariablesString `tfsdk:"source_vars"`
+	SourceProject        types.Int32     `tfsdk:"source_project"`
+	ScmBranch            types.String    `tfsdk:"scm_branch"`
+	UpdateCacheTimeout   types.Int32     `tfsdk:"update_cache_timeout"`
+	UpdateOnLaunch       types.Bool      `tfsdk:"update_on_launch"`
+	Verbosity            types.Int32     `tfsdk:"verbosity"`
+}
+
+// The inventory source resource's model, adding the settings of the resource itself to the inventory source's
+// fields.
+type InventorySourceResourceModel struct {
+	InventorySourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type InventorySourceAPIModel struct {
@@ -270,51 +294,52 @@ type InventorySourceAPIModel struct {
 }
 
 type JobTemplateModel struct {
-	Id                             types.String `tfsdk:"id"`
-	Name                           types.String `tfsdk:"name"`
-	Description                    types.String `tfsdk:"description"`
-	JobType                        types.String `tfsdk:"job_type"`
-	Inventory                      types.Int32  `tfsdk:"inventory"`
-	Project                        types.Int32  `tfsdk:"project"`
-	Playbook                       types.String `tfsdk:"playbook"`
-	ScmBranch                      types.String `tfsdk:"scm_branch"`
-	Forks                          types.Int32  `tfsdk:"forks"`
-	Limit                          types.String `tfsdk:"limit"`
-	Verbosity                      types.Int32  `tfsdk:"verbosity"`
-	ExtraVars                      types.String `tfsdk:"extra_vars"`
-	JobTags                        types.String `tfsdk:"job_tags"`
-	ForceHandlers                  types.Bool   `tfsdk:"force_handlers"`
-	SkipTags                       types.String `tfsdk:"skip_tags"`
-	StartAtTask                    types.String `tfsdk:"start_at_task"`
-	Timeout                        types.Int32  `tfsdk:"timeout"`
-	UseFactCache                   types.Bool   `tfsdk:"use_fact_cache"`
-	ExecutionEnvironment           types.Int32  `tfsdk:"execution_environment"`
-	HostConfigKey                  types.String `tfsdk:"host_config_key"`
-	AskScmBranchOnLaunch           types.Bool   `tfsdk:"ask_scm_branch_on_launch"`
-	AskDiffModeOnLaunch            types.Bool   `tfsdk:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch           types.Bool   `tfsdk:"ask_variables_on_launch"`
-	AskLimitOnLaunch               types.Bool   `tfsdk:"ask_limit_on_launch"`
-	AskTagsOnLaunch                types.Bool   `tfsdk:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch            types.Bool   `tfsdk:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch             types.Bool   `tfsdk:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch           types.Bool   `tfsdk:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch           types.Bool   `tfsdk:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch          types.Bool   `tfsdk:"ask_credential_on_launch"`
-	AskExecutionEnvironmenOnLaunch types.Bool   `tfsdk:"ask_execution_environment_on_launch"`
-	AskLabelsOnLaunch              types.Bool   `tfsdk:"ask_labels_on_launch"`
-	AskForksOnLaunch               types.Bool   `tfsdk:"ask_forks_on_launch"`
-	AskJobSliceCountOnLaunch       types.Bool   `tfsdk:"ask_job_slice_count_on_launch"`
-	AskTimeoutOnLaunch             types.Bool   `tfsdk:"ask_timeout_on_launch"`
-	AskInstanceGroupsOnLaunch      types.Bool   `tfsdk:"ask_instance_groups_on_launch"`
-	SurveyEnabled                  types.Bool   `tfsdk:"survey_enabled"`
-	BecomeEnabled                  types.Bool   `tfsdk:"become_enabled"`
-	DiffMode                       types.Bool   `tfsdk:"diff_mode"`
-	AllowSimultaneous              types.Bool   `tfsdk:"allow_simultaneous"`
-	CustomVirtualEnv               types.String `tfsdk:"custom_virtualenv"`
-	JobSliceCount                  types.Int32  `tfsdk:"job_slice_count"`
-	WebhookService                 types.String `tfsdk:"webhook_service"`
-	WebhookCredential              types.String `tfsdk:"webhook_credential"`
-	PreventInstanceGroupFallback   types.Bool   `tfsdk:"prevent_instance_group_fallback"`
+	Id                             types.String    `tfsdk:"id"`
+	Name                           types.String    `tfsdk:"name"`
+	Description                    types.String    `tfsdk:"description"`
+	JobType                        types.String    `tfsdk:"job_type"`
+	Inventory                      types.Int32     `tfsdk:"inventory"`
+	Project                        types.Int32     `tfsdk:"project"`
+	Playbook                       types.String    `tfsdk:"playbook"`
+	ScmBranch                      types.String    `tfsdk:"scm_branch"`
+	Forks                          types.Int32     `tfsdk:"forks"`
+	Limit                          types.String    `tfsdk:"limit"`
+	Verbosity                      types.Int32     `tfsdk:"verbosity"`
+	ExtraVars                      VariablesString `tfsdk:"extra_vars"`
+	ExtraVarsMap                   types.Dynamic   `tfsdk:"extra_vars_map"`
+	JobTags                        types.String    `tfsdk:"job_tags"`
+	ForceHandlers                  types.Bool      `tfsdk:"force_handlers"`
+	SkipTags                       types.String    `tfsdk:"skip_tags"`
+	StartAtTask                    types.String    `tfsdk:"start_at_task"`
+	Timeout                        types.Int32     `tfsdk:"timeout"`
+	UseFactCache                   types.Bool      `tfsdk:"use_fact_cache"`
+	ExecutionEnvironment           types.Int32     `tfsdk:"execution_environment"`
+	HostConfigKey                  types.String    `tfsdk:"host_config_key"`
+	AskScmBranchOnLaunch           types.Bool      `tfsdk:"ask_scm_branch_on_launch"`
+	AskDiffModeOnLaunch            types.Bool      `tfsdk:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch           types.Bool      `tfsdk:"ask_variables_on_launch"`
+	AskLimitOnLaunch               types.Bool      `tfsdk:"ask_limit_on_launch"`
+	AskTagsOnLaunch                types.Bool      `tfsdk:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch            types.Bool      `tfsdk:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch             types.Bool      `tfsdk:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch           types.Bool      `tfsdk:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch           types.Bool      `tfsdk:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch          types.Bool      `tfsdk:"ask_credential_on_launch"`
+	AskExecutionEnvironmenOnLaunch types.Bool      `tfsdk:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch              types.Bool      `tfsdk:"ask_labels_on_launch"`
+	AskForksOnLaunch               types.Bool      `tfsdk:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch       types.Bool      `tfsdk:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch             types.Bool      `tfsdk:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch      types.Bool      `tfsdk:"ask_instance_groups_on_launch"`
+	SurveyEnabled                  types.Bool      `tfsdk:"survey_enabled"`
+	BecomeEnabled                  types.Bool      `tfsdk:"become_enabled"`
+	DiffMode                       types.Bool      `tfsdk:"diff_mode"`
+	AllowSimultaneous              types.Bool      `tfsdk:"allow_simultaneous"`
+	CustomVirtualEnv               types.String    `tfsdk:"custom_virtualenv"`
+	JobSliceCount                  types.Int32     `tfsdk:"job_slice_count"`
+	WebhookService                 types.String    `tfsdk:"webhook_service"`
+	WebhookCredential              types.String    `tfsdk:"webhook_credential"`
+	PreventInstanceGroupFallback   types.Bool      `tfsdk:"prevent_instance_group_fallback"`
 }
 
 type JobTemplateAPIModel struct {
@@ -462,6 +487,15 @@ type ProjectModel struct {
 	ScmUpdateCacheTimeout types.Int32  `tfsdk:"scm_update_cache_timeout"`
 	ScmUrl                types.String `tfsdk:"scm_url"`
 	Timeout               types.Int32  `tfsdk:"timeout"`
+	ScmRevision           types.String `tfsdk:"scm_revision"`
+	Playbooks             types.List   `tfsdk:"playbooks"`
+}
+
+// The project resource's model, adding the settings of the resource itself to the project's fields.
+type ProjectResourceModel struct {
+	ProjectModel
+	WaitForSync types.Bool     `tfsdk:"wait_for_sync"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ProjectAPIModel struct {
@@ -483,6 +517,7 @@ type ProjectAPIModel struct {
 	ScmUpdateCacheTimeout int    `json:"scm_update_cache_timeout,omitempty"`
 	ScmUrl                string `json:"scm_url,omitempty"`
 	Timeout               int    `json:"timeout"`
+	ScmRevision           string `json:"scm_revision,omitempty"`
 }
 
 type RoleDefinitionModel struct {
@@ -614,27 +649,27 @@ type UserAPIModel struct {
 }
 
 type WorkflowJobTemplatesResourceModel struct {
-	Id                   types.String `tfsdk:"id"`
-	Name                 types.String `tfsdk:"name"`
-	Description          types.String `tfsdk:"description"`
-	ExtraVars            types.String `tfsdk:"extra_vars"`
-	Organization         types.Int32  `tfsdk:"organization"`
-	SurveyEnabled        types.Bool   `tfsdk:"survey_enabled"`
-	AllowSimultaneous    types.Bool   `tfsdk:"allow_simultaneous"`
-	AskVariablesOnLaunch types.Bool   `tfsdk:"ask_variables_on_launch"`
-	Inventory            types.Int32  `tfsdk:"inventory"`
-	Limit                types.String `tfsdk:"limit"`
-	ScmBranch            types.String `tfsdk:"scm_branch"`
-	AskInventoryOnLaunch types.Bool   `tfsdk:"ask_inventory_on_launch"`
-	AskScmBranchOnLaunch types.Bool   `tfsdk:"ask_scm_branch_on_launch"`
-	AskLimitOnLaunch     types.Bool   `tfsdk:"ask_limit_on_launch"`
-	WebhookService       types.String `tfsdk:"webhook_service"`
-	WebhookCredential    types.String `tfsdk:"webhook_credential"`
-	AskLabelsOnLaunch    types.Bool   `tfsdk:"ask_labels_on_launch"`
-	AskSkipTagsOnLaunch  types.Bool   `tfsdk:"ask_skip_tags_on_launch"`
-	AskTagsOnLaunch      types.Bool   `tfsdk:"ask_tags_on_launch"`
-	SkipTags             types.String `tfsdk:"skip_tags"`
-	JobTags              types.String `tfsdk:"job_tags"`
+	Id                   types.String    `tfsdk:"id"`
+	Name                 types.String    `tfsdk:"name"`
+	Description          types.String    `tfsdk:"description"`
+	ExtraVars            VariablesString `tfsdk:"extra_vars"`
+	Organization         types.Int32     `tfsdk:"organization"`
+	SurveyEnabled        types.Bool      `tfsdk:"survey_enabled"`
+	AllowSimultaneous    types.Bool      `tfsdk:"allow_simultaneous"`
+	AskVariablesOnLaunch types.Bool      `tfsdk:"ask_variables_on_launch"`
+	Inventory            types.Int32     `tfsdk:"inventory"`
+	Limit                types.String    `tfsdk:"limit"`
+	ScmBranch            types.String    `tfsdk:"scm_branch"`
+	AskInventoryOnLaunch types.Bool      `tfsdk:"ask_inventory_on_launch"`
+	AskScmBranchOnLaunch types.Bool      `tfsdk:"ask_scm_branch_on_launch"`
+	AskLimitOnLaunch     types.Bool      `tfsdk:"ask_limit_on_launch"`
+	WebhookService       types.String    `tfsdk:"webhook_service"`
+	WebhookCredential    types.String    `tfsdk:"webhook_credential"`
+	AskLabelsOnLaunch    types.Bool      `tfsdk:"ask_labels_on_launch"`
+	AskSkipTagsOnLaunch  types.Bool      `tfsdk:"ask_skip_tags_on_launch"`
+	AskTagsOnLaunch      types.Bool      `tfsdk:"ask_tags_on_launch"`
+	SkipTags             types.String    `tfsdk:"skip_tags"`
+	JobTags              types.String    `tfsdk:"job_tags"`
 }
 
 type WorkflowJobTemplateAPIModel struct {
@@ -678,3 +713,88 @@ type CredentialInputSourcesAPIModel struct {
 	TargetCredential int               `json:"target_credential"`
 	SourceCredential int               `json:"source_credential"`
 }
+
+type JobLaunchModel struct {
+	Id                types.String    `tfsdk:"id"`
+	JobTemplate       types.Int32     `tfsdk:"job_template"`
+	ExtraVars         VariablesString `tfsdk:"extra_vars"`
+	Limit             types.String    `tfsdk:"limit"`
+	Inventory         types.Int32     `tfsdk:"inventory"`
+	Credentials       types.Set       `tfsdk:"credentials"`
+	JobTags           types.String    `tfsdk:"job_tags"`
+	SkipTags          types.String    `tfsdk:"skip_tags"`
+	JobType           types.String    `tfsdk:"job_type"`
+	Triggers          types.Map       `tfsdk:"triggers"`
+	WaitForCompletion types.Bool      `tfsdk:"wait_for_completion"`
+	Status            types.String    `tfsdk:"status"`
+	Elapsed           types.Float64   `tfsdk:"elapsed"`
+	Artifacts         types.Dynamic   `tfsdk:"artifacts"`
+	Timeouts          timeouts.Value  `tfsdk:"timeouts"`
+}
+
+type JobLaunchAPIModel struct {
+	ExtraVars   string `json:"extra_vars,omitempty"`
+	Limit       string `json:"limit,omitempty"`
+	Inventory   int    `json:"inventory,omitempty"`
+	Credentials []int  `json:"credentials,omitempty"`
+	JobTags     string `json:"job_tags,omitempty"`
+	SkipTags    string `json:"skip_tags,omitempty"`
+	JobType     string `json:"job_type,omitempty"`
+}
+
+type WorkflowJobLaunchModel struct {
+	Id                  types.String    `tfsdk:"id"`
+	WorkflowJobTemplate types.Int32     `tfsdk:"workflow_job_template"`
+	ExtraVars           VariablesString `tfsdk:"extra_vars"`
+	Inventory           types.Int32     `tfsdk:"inventory"`
+	Limit               types.String    `tfsdk:"limit"`
+	ScmBranch           types.String    `tfsdk:"scm_branch"`
+	JobTags             types.String    `tfsdk:"job_tags"`
+	SkipTags            types.String    `tfsdk:"skip_tags"`
+	Triggers            types.Map       `tfsdk:"triggers"`
+	WaitForCompletion   types.Bool      `tfsdk:"wait_for_completion"`
+	PendingApprovals    types.String    `tfsdk:"pending_approvals"`
+	Status              types.String    `tfsdk:"status"`
+	Elapsed             types.Float64   `tfsdk:"elapsed"`
+	Nodes               types.List      `tfsdk:"nodes"`
+	Timeouts            timeouts.Value  `tfsdk:"timeouts"`
+}
+
+type WorkflowJobLaunchNodeModel struct {
+	NodeId  types.Int64  `tfsdk:"node_id"`
+	Name    types.String `tfsdk:"name"`
+	JobId   types.Int64  `tfsdk:"job_id"`
+	JobType types.String `tfsdk:"job_type"`
+	Status  types.String `tfsdk:"status"`
+}
+
+type WorkflowJobLaunchAPIModel struct {
+	ExtraVars string `json:"extra_vars,omitempty"`
+	Inventory int    `json:"inventory,omitempty"`
+	Limit     string `json:"limit,omitempty"`
+	ScmBranch string `json:"scm_branch,omitempty"`
+	JobTags   string `json:"job_tags,omitempty"`
+	SkipTags  string `json:"skip_tags,omitempty"`
+}
+
+type ProjectUpdateActionModel struct {
+	Project  types.Int32          `tfsdk:"project"`
+	Timeouts actiontimeouts.Value `tfsdk:"timeouts"`
+}
+
+type InventorySourceUpdateActionModel struct {
+	InventorySource types.Int32          `tfsdk:"inventory_source"`
+	Timeouts        actiontimeouts.Value `tfsdk:"timeouts"`
+}
+
+type JobTemplateLaunchActionModel struct {
+	JobTemplate types.Int32          `tfsdk:"job_template"`
+	ExtraVars   VariablesString      `tfsdk:"extra_vars"`
+	Limit       types.String         `tfsdk:"limit"`
+	Inventory   types.Int32          `tfsdk:"inventory"`
+	Credentials types.Set            `tfsdk:"credentials"`
+	JobTags     types.String         `tfsdk:"job_tags"`
+	SkipTags    types.String         `tfsdk:"skip_tags"`
+	JobType     types.String         `tfsdk:"job_type"`
+	Timeouts    actiontimeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var _ basetypes.StringTypable = VariablesStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = VariablesString{}
+
+// The type of string attributes holding variables as a YAML or JSON document, such as `variables` and
+// `extra_vars`. The controller reformats YAML and reorders JSON keys, so two values are equal when they hold the
+// same document, however it's written.
+type VariablesStringType struct {
+	basetypes.StringType
+}
+
+func (t VariablesStringType) String() string {
+	return "VariablesStringType"
+}
+
+func (t VariablesStringType) ValueType(ctx context.Context) attr.Value {
+	return VariablesString{}
+}
+
+func (t VariablesStringType) Equal(o attr.Type) bool {
+	other, ok := o.(VariablesStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t VariablesStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return VariablesString{StringValue: in}, nil
+}
+
+func (t VariablesStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// A value of VariablesStringType.
+type VariablesString struct {
+	basetypes.StringValue
+}
+
+func NewVariablesStringNull() VariablesString {
+	return VariablesString{StringValue: basetypes.NewStringNull()}
+}
+
+func NewVariablesStringValue(value string) VariablesString {
+	return VariablesString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v VariablesString) Type(ctx context.Context) attr.Type {
+	return VariablesStringType{}
+}
+
+func (v VariablesString) Equal(o attr.Value) bool {
+	other, ok := o.(VariablesString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Reports whether the new value holds the same document as v, in which case the framework keeps v, the way it
+// was configured, in state.
+func (v VariablesString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(VariablesString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	return variablesEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// Whether a and b hold the same YAML or JSON document. Documents that don't parse are only equal when the strings
+// are.
+func variablesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	documentA, err := parseVariables(a)
+	if err != nil {
+		return false
+	}
+
+	documentB, err := parseVariables(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(documentA, documentB)
+}
+
+// Parses a YAML or JSON document into the values encoding/json would decode it to, so that, i.e., the YAML `1`
+// and the JSON `1.0` compare equal. An empty document, such as "" or "---", is the same as an empty mapping, which
+// is what the controller stores when no variables are given.
+func parseVariables(s string) (any, error) {
+	var document any
+	if err := yaml.Unmarshal([]byte(s), &document); err != nil {
+		return nil, err
+	}
+
+	if document == nil {
+		return map[string]any{}, nil
+	}
+
+	encoded, err := json.Marshal(jsonCompatible(document))
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+	if err := json.Unmarshal(encoded, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// Converts the mappings with non-string keys that YAML allows into ones encoding/json can encode.
+func jsonCompatible(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		converted := make(map[string]any, len(value))
+		for key, element := range value {
+			converted[key] = jsonCompatible(element)
+		}
+		return converted
+	case map[any]any:
+		converted := make(map[string]any, len(value))
+		for key, element := range value {
+			converted[fmt.Sprint(key)] = jsonCompatible(element)
+		}
+		return converted
+	case []any:
+		converted := make([]any, len(value))
+		for i, element := range value {
+			converted[i] = jsonCompatible(element)
+		}
+		return converted
+	}
+
+	return value
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestVariablesString_semanticEquals(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		equal bool
+	}{
+		{`{"foo": "bar", "baz": 1}`, `{"baz":1,"foo":"bar"}`, true},
+		{`{"foo":"bar"}`, "---\nfoo: bar\n", true},
+		{"foo: bar\nlist:\n  - 1\n  - two\n", `{"list": [1, "two"], "foo": "bar"}`, true},
+		{`{"count": 1.0}`, "count: 1", true},
+		{"", "---", true},
+		{"", "{}", true},
+		{"---\n", `{}`, true},
+		{"1: one\n", `{"1": "one"}`, true},
+		{`{"foo":"bar"}`, `{"foo":"baz"}`, false},
+		{`{"list":[1,2]}`, `{"list":[2,1]}`, false},
+		{`{"foo":"bar"}`, "", false},
+		{"foo: [", "foo: [", true},
+		{"foo: [", "foo: []", false},
+	}
+
+	for _, c := range cases {
+		equal, diags := NewVariablesStringValue(c.a).StringSemanticEquals(context.Background(), NewVariablesStringValue(c.b))
+		if diags.HasError() {
+			t.Errorf("%q, %q: unexpected error: %v", c.a, c.b, diags)
+			continue
+		}
+		if equal != c.equal {
+			t.Errorf("%q, %q: expected semantic equality to be %v, got %v", c.a, c.b, c.equal, equal)
+		}
+	}
+}
+
+func TestVariablesMap_roundTrip(t *testing.T) {
+	cases := []string{
+		`{}`,
+		`{"foo":"bar","count":3,"enabled":true,"nothing":null}`,
+		`{"list":[1,"two",{"three":3}],"nested":{"a":{"b":[]}}}`,
+		"---\nhosts:\n  - web1\n  - web2\nport: 8080.5\n",
+	}
+
+	for _, c := range cases {
+		value, diags := variablesStringToDynamic(c)
+		if diags.HasError() {
+			t.Errorf("%q: unexpected error decoding: %v", c, diags)
+			continue
+		}
+
+		encoded, diags := variablesMapToString(value)
+		if diags.HasError() {
+			t.Errorf("%q: unexpected error encoding: %v", c, diags)
+			continue
+		}
+
+		if !variablesEqual(c, encoded) {
+			t.Errorf("%q: expected the round trip to hold the same document, got %q", c, encoded)
+		}
+	}
+}
@@ -33,6 +33,11 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform stops the plugin once it's done with it, which is the last chance to undo any logins
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), provider.ShutdownTimeout)
+	provider.Shutdown(shutdownCtx)
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}